@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// unauthenticatedPaths can be requested without credentials (e.g. liveness and readiness probes)
+var unauthenticatedPaths = map[string]bool{
+	"/-/healthy": true,
+	"/-/ready":   true,
+}
+
+// withBasicAuth requires HTTP basic auth for all requests except health checks
+func withBasicAuth(next http.Handler, username, password string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unauthenticatedPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		u, p, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(u), []byte(username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(p), []byte(password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="junos_exporter"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func loadBasicAuthPassword(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read basic auth password file")
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
@@ -8,45 +8,88 @@ import (
 	"github.com/czerwonk/junos_exporter/pkg/connector"
 	"github.com/czerwonk/junos_exporter/pkg/features/accounting"
 	"github.com/czerwonk/junos_exporter/pkg/features/alarm"
+	"github.com/czerwonk/junos_exporter/pkg/features/arp"
 	"github.com/czerwonk/junos_exporter/pkg/features/bfd"
 	"github.com/czerwonk/junos_exporter/pkg/features/bgp"
+	"github.com/czerwonk/junos_exporter/pkg/features/bgpdamping"
+	"github.com/czerwonk/junos_exporter/pkg/features/commit"
+	"github.com/czerwonk/junos_exporter/pkg/features/cos"
+	"github.com/czerwonk/junos_exporter/pkg/features/ddosprotection"
+	"github.com/czerwonk/junos_exporter/pkg/features/dhcp"
 	"github.com/czerwonk/junos_exporter/pkg/features/environment"
+	"github.com/czerwonk/junos_exporter/pkg/features/evpn"
+	"github.com/czerwonk/junos_exporter/pkg/features/fabric"
 	"github.com/czerwonk/junos_exporter/pkg/features/firewall"
+	"github.com/czerwonk/junos_exporter/pkg/features/flowmonitoring"
 	"github.com/czerwonk/junos_exporter/pkg/features/fpc"
+	"github.com/czerwonk/junos_exporter/pkg/features/gnmi"
 	"github.com/czerwonk/junos_exporter/pkg/features/interfacediagnostics"
 	"github.com/czerwonk/junos_exporter/pkg/features/interfacequeue"
 	"github.com/czerwonk/junos_exporter/pkg/features/interfaces"
 	"github.com/czerwonk/junos_exporter/pkg/features/ipsec"
 	"github.com/czerwonk/junos_exporter/pkg/features/isis"
 	"github.com/czerwonk/junos_exporter/pkg/features/l2circuit"
+	"github.com/czerwonk/junos_exporter/pkg/features/l2vpn"
 	"github.com/czerwonk/junos_exporter/pkg/features/lacp"
 	"github.com/czerwonk/junos_exporter/pkg/features/ldp"
 	"github.com/czerwonk/junos_exporter/pkg/features/mac"
 	"github.com/czerwonk/junos_exporter/pkg/features/mplslsp"
+	"github.com/czerwonk/junos_exporter/pkg/features/multicast"
 	"github.com/czerwonk/junos_exporter/pkg/features/nat"
 	"github.com/czerwonk/junos_exporter/pkg/features/nat2"
+	"github.com/czerwonk/junos_exporter/pkg/features/ntp"
 	"github.com/czerwonk/junos_exporter/pkg/features/ospf"
+	"github.com/czerwonk/junos_exporter/pkg/features/ospf3"
+	"github.com/czerwonk/junos_exporter/pkg/features/poe"
 	"github.com/czerwonk/junos_exporter/pkg/features/power"
 	"github.com/czerwonk/junos_exporter/pkg/features/route"
 	"github.com/czerwonk/junos_exporter/pkg/features/routingengine"
 	"github.com/czerwonk/junos_exporter/pkg/features/rpki"
 	"github.com/czerwonk/junos_exporter/pkg/features/rpm"
+	"github.com/czerwonk/junos_exporter/pkg/features/rsvp"
 	"github.com/czerwonk/junos_exporter/pkg/features/security"
 	"github.com/czerwonk/junos_exporter/pkg/features/securityike"
 	"github.com/czerwonk/junos_exporter/pkg/features/securitypolicies"
+	"github.com/czerwonk/junos_exporter/pkg/features/softwareversion"
 	"github.com/czerwonk/junos_exporter/pkg/features/storage"
+	"github.com/czerwonk/junos_exporter/pkg/features/stormcontrol"
 	"github.com/czerwonk/junos_exporter/pkg/features/subscriber"
 	"github.com/czerwonk/junos_exporter/pkg/features/system"
+	"github.com/czerwonk/junos_exporter/pkg/features/users"
 	"github.com/czerwonk/junos_exporter/pkg/features/vpws"
 	"github.com/czerwonk/junos_exporter/pkg/features/vrrp"
 	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
 )
 
+// logicalSystemCollectors are the collectors scoping their commands to a logical system.
+// Only these collectors run when scraping a logical system other than the default one.
+var logicalSystemCollectors = map[string]bool{
+	"bfd":      true,
+	"bgp":      true,
+	"isis":     true,
+	"ldp":      true,
+	"mpls_lsp": true,
+	"ospf":     true,
+	"ospf3":    true,
+	"routes":   true,
+	"rsvp":     true,
+}
+
+// routingInstanceCollectors are the collectors scoping their commands to a routing instance.
+// Only these collectors run when scraping a routing instance.
+// The routes collector is missing on purpose, the route summary of the default instance contains the tables of all routing instances.
+var routingInstanceCollectors = map[string]bool{
+	"bgp":   true,
+	"ospf":  true,
+	"ospf3": true,
+}
+
 type collectors struct {
 	logicalSystem string
 	dynamicLabels *interfacelabels.DynamicLabels
 	collectors    map[string]collector.RPCCollector
 	devices       map[string][]collector.RPCCollector
+	known         map[string]bool
 	cfg           *config.Config
 }
 
@@ -56,6 +99,7 @@ func collectorsForDevices(devices []*connector.Device, cfg *config.Config, logic
 		dynamicLabels: dynamicLabels,
 		collectors:    make(map[string]collector.RPCCollector),
 		devices:       make(map[string][]collector.RPCCollector),
+		known:         make(map[string]bool),
 		cfg:           cfg,
 	}
 
@@ -71,37 +115,36 @@ func (c *collectors) initCollectorsForDevices(device *connector.Device) {
 
 	c.devices[device.Host] = make([]collector.RPCCollector, 0)
 
-	c.addCollectorIfEnabledForDevice(device, "routingengine", f.RoutingEngine, routingengine.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "routing_engine", f.RoutingEngine, routingengine.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "accounting", f.Accounting, accounting.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "alarm", f.Alarm, func() collector.RPCCollector {
 		return alarm.NewCollector(*alarmFilter)
 	})
 	c.addCollectorIfEnabledForDevice(device, "bfd", f.BFD, bfd.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "bgp", f.BGP, func() collector.RPCCollector {
-		return bgp.NewCollector(c.logicalSystem)
-	})
-	c.addCollectorIfEnabledForDevice(device, "env", f.Environment, environment.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "bgp", f.BGP, bgp.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "environment", f.Environment, environment.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "firewall", f.Firewall, firewall.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "fpc", f.FPC, fpc.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "ifacediag", f.InterfaceDiagnostic, func() collector.RPCCollector {
+	c.addCollectorIfEnabledForDevice(device, "interface_diagnostic", f.InterfaceDiagnostic, func() collector.RPCCollector {
 		return interfacediagnostics.NewCollector(c.dynamicLabels)
 	})
-	c.addCollectorIfEnabledForDevice(device, "ifacequeue", f.InterfaceQueue, func() collector.RPCCollector {
-		return interfacequeue.NewCollector(c.dynamicLabels)
+	c.addCollectorIfEnabledForDevice(device, "interface_queue", f.InterfaceQueue, func() collector.RPCCollector {
+		return interfacequeue.NewCollector(c.dynamicLabels, c.interfaceFilterForDevice)
 	})
-	c.addCollectorIfEnabledForDevice(device, "iface", f.Interfaces, func() collector.RPCCollector {
-		return interfaces.NewCollector(c.dynamicLabels)
+	c.addCollectorIfEnabledForDevice(device, "interfaces", f.Interfaces, func() collector.RPCCollector {
+		return interfaces.NewCollector(c.dynamicLabels, c.interfaceFilterForDevice, *interfaceRates)
+	})
+	c.addCollectorIfEnabledForDevice(device, "ipsec", f.IPSec, func() collector.RPCCollector {
+		return ipsec.NewCollector(*ipsecTunnelStatistics)
 	})
-	c.addCollectorIfEnabledForDevice(device, "ipsec", f.IPSec, ipsec.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "isis", f.ISIS, isis.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "l2c", f.L2Circuit, l2circuit.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "l2circuit", f.L2Circuit, l2circuit.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "lacp", f.LACP, lacp.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "ldp", f.LDP, ldp.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "nat", f.NAT, nat.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "nat2", f.NAT2, nat2.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "ospf", f.OSPF, func() collector.RPCCollector {
-		return ospf.NewCollector(c.logicalSystem)
-	})
+	c.addCollectorIfEnabledForDevice(device, "ospf", f.OSPF, ospf.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "ospf3", f.OSPF3, ospf3.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "routes", f.Routes, route.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "rpki", f.RPKI, rpki.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "rpm", f.RPM, rpm.NewCollector)
@@ -110,19 +153,59 @@ func (c *collectors) initCollectorsForDevices(device *connector.Device) {
 	c.addCollectorIfEnabledForDevice(device, "security_policies", f.SecurityPolicies, securitypolicies.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "storage", f.Storage, storage.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "system", (f.System || f.License), system.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "power", f.Power, power.NewCollector)
-	c.addCollectorIfEnabledForDevice(device, "mac", f.MAC, mac.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "power", f.Power, func() collector.RPCCollector {
+		return power.NewCollector(*powerFRU)
+	})
+	c.addCollectorIfEnabledForDevice(device, "mac", f.MAC, func() collector.RPCCollector {
+		return mac.NewCollector(*macVLANCounts)
+	})
 	c.addCollectorIfEnabledForDevice(device, "vrrp", f.VRRP, vrrp.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "vpws", f.VPWS, vpws.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "mpls_lsp", f.MPLSLSP, mplslsp.NewCollector)
 	c.addCollectorIfEnabledForDevice(device, "subscriber", f.Subscriber, subscriber.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "users", f.Users, func() collector.RPCCollector {
+		return users.NewCollector(*usersLoginClasses)
+	})
+	c.addCollectorIfEnabledForDevice(device, "flow_monitoring", f.FlowMonitoring, flowmonitoring.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "cos", f.CoS, cos.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "fabric", f.Fabric, fabric.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "poe", f.PoE, poe.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "arp", f.ARP, arp.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "commit", f.Commit, commit.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "bgp_damping", f.BGPDamping, bgpdamping.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "evpn", f.EVPN, evpn.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "ntp", f.NTP, ntp.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "dhcp", f.DHCP, dhcp.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "multicast", f.Multicast, func() collector.RPCCollector {
+		return multicast.NewCollector(*multicastRouteLimit)
+	})
+	c.addCollectorIfEnabledForDevice(device, "l2vpn", f.L2VPN, l2vpn.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "rsvp", f.RSVP, rsvp.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "version", f.Version, softwareversion.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "ddos_protection", f.DDoSProtection, ddosprotection.NewCollector)
+	c.addCollectorIfEnabledForDevice(device, "storm_control", f.StormControl, func() collector.RPCCollector {
+		return stormcontrol.NewCollector(stormControlTriggers)
+	})
+	c.addCollectorIfEnabledForDevice(device, "gnmi", f.GNMI, func() collector.RPCCollector {
+		return gnmi.NewCollector(telemetryCache)
+	})
 }
 
 func (c *collectors) addCollectorIfEnabledForDevice(device *connector.Device, key string, enabled bool, newCollector func() collector.RPCCollector) {
+	c.known[key] = true
+
 	if !enabled {
 		return
 	}
 
+	if c.logicalSystem != "" && !logicalSystemCollectors[key] {
+		return
+	}
+
+	if !c.cfg.CollectorSetForDevice(device.Host).Allows(key) {
+		return
+	}
+
 	col, found := c.collectors[key]
 	if !found {
 		col = newCollector()
@@ -132,6 +215,58 @@ func (c *collectors) addCollectorIfEnabledForDevice(device *connector.Device, ke
 	c.devices[device.Host] = append(c.devices[device.Host], col)
 }
 
+func (c *collectors) interfaceFilterForDevice(host string) *interfaces.Filter {
+	fc := c.cfg.InterfaceFilterForDevice(host)
+	if fc == nil {
+		return nil
+	}
+
+	// the patterns are validated when the config is loaded
+	f, _ := interfaces.NewFilter(fc.Include, fc.Exclude)
+	return f
+}
+
+// restrictTo removes all collectors whose name is not in names
+func (c *collectors) restrictTo(names map[string]bool) {
+	allowed := make(map[collector.RPCCollector]bool)
+	for key, col := range c.collectors {
+		if names[key] {
+			allowed[col] = true
+			continue
+		}
+
+		delete(c.collectors, key)
+	}
+
+	for host, cols := range c.devices {
+		filtered := make([]collector.RPCCollector, 0, len(cols))
+		for _, col := range cols {
+			if allowed[col] {
+				filtered = append(filtered, col)
+			}
+		}
+
+		c.devices[host] = filtered
+	}
+}
+
+// nameFor returns the name (as used in features) of a collector
+func (c *collectors) nameFor(col collector.RPCCollector) string {
+	for key, cl := range c.collectors {
+		if cl == col {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// collectorNames returns the names of all available collectors
+func collectorNames() map[string]bool {
+	c := collectorsForDevices([]*connector.Device{{}}, config.New(), "", interfacelabels.NewDynamicLabels())
+	return c.known
+}
+
 func (c *collectors) allEnabledCollectors() []collector.RPCCollector {
 	collectors := make([]collector.RPCCollector, len(c.collectors))
 
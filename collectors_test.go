@@ -3,8 +3,10 @@
 package main
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/czerwonk/junos_exporter/internal/config"
@@ -96,3 +98,123 @@ func TestCollectorsForDevices(t *testing.T) {
 	assert.Equal(t, 1, len(cd2), "device 2 collector count")
 	assert.Equal(t, "Interfaces", cd2[0].Name(), "device 2 collector name")
 }
+
+func TestCollectorsRestrictTo(t *testing.T) {
+	c := &config.Config{
+		Features: config.FeatureConfig{
+			BGP:        true,
+			Interfaces: true,
+			OSPF:       true,
+		},
+	}
+
+	d := &connector.Device{
+		Host: "2001:678:1e0::1",
+	}
+	cols := collectorsForDevices([]*connector.Device{d}, c, "", interfacelabels.NewDynamicLabels())
+	cols.restrictTo(map[string]bool{"bgp": true, "interfaces": true, "isis": true})
+
+	assert.Equal(t, 2, len(cols.collectors), "collector count")
+
+	cd := cols.collectorsForDevice(d)
+	assert.Equal(t, 2, len(cd), "device collector count")
+	assert.Equal(t, "BGP", cd[0].Name(), "device collector name")
+	assert.Equal(t, "Interfaces", cd[1].Name(), "device collector name")
+}
+
+func TestCollectorsNameFor(t *testing.T) {
+	c := &config.Config{
+		Features: config.FeatureConfig{
+			BGP:           true,
+			RoutingEngine: true,
+		},
+	}
+
+	d := &connector.Device{
+		Host: "2001:678:1e0::1",
+	}
+	cols := collectorsForDevices([]*connector.Device{d}, c, "", interfacelabels.NewDynamicLabels())
+
+	assert.Equal(t, "bgp", cols.nameFor(cols.collectors["bgp"]))
+	assert.Equal(t, "routing_engine", cols.nameFor(cols.collectors["routing_engine"]))
+	assert.Equal(t, "", cols.nameFor(nil), "unknown collector")
+}
+
+func TestCollectorNames(t *testing.T) {
+	names := collectorNames()
+
+	assert.True(t, names["bgp"], "bgp")
+	assert.True(t, names["interfaces"], "interfaces")
+	assert.True(t, names["routing_engine"], "routing_engine")
+	assert.False(t, names["unknown"], "unknown")
+}
+
+func TestCollectorsForLogicalSystem(t *testing.T) {
+	c := &config.Config{
+		Features: config.FeatureConfig{
+			BGP:        true,
+			OSPF:       true,
+			Interfaces: true,
+			Routes:     true,
+			Storage:    true,
+		},
+	}
+
+	d := &connector.Device{Host: "::1"}
+	cols := collectorsForDevices([]*connector.Device{d}, c, "ls1", interfacelabels.NewDynamicLabels())
+
+	assert.Equal(t, 3, len(cols.collectorsForDevice(d)), "logical system collectors")
+	assert.Contains(t, cols.collectors, "bgp")
+	assert.Contains(t, cols.collectors, "ospf")
+	assert.Contains(t, cols.collectors, "routes")
+}
+
+func TestCollectorsForDeviceWithCollectorSet(t *testing.T) {
+	c := &config.Config{
+		Features: config.FeatureConfig{
+			BGP:        true,
+			Firewall:   true,
+			Interfaces: true,
+		},
+		Devices: []*config.DeviceConfig{
+			{Host: "srx1", Collectors: &config.CollectorSetConfig{Include: []string{"firewall", "interfaces"}}},
+			{Host: "mx1", Collectors: &config.CollectorSetConfig{Exclude: []string{"firewall"}}},
+			{Host: "ex1"},
+		},
+	}
+
+	srx := &connector.Device{Host: "srx1"}
+	mx := &connector.Device{Host: "mx1"}
+	ex := &connector.Device{Host: "ex1"}
+	cols := collectorsForDevices([]*connector.Device{srx, mx, ex}, c, "", interfacelabels.NewDynamicLabels())
+
+	names := func(d *connector.Device) []string {
+		n := make([]string, 0)
+		for _, col := range cols.collectorsForDevice(d) {
+			n = append(n, col.Name())
+		}
+		return n
+	}
+
+	assert.Equal(t, []string{"Firewall", "Interfaces"}, names(srx), "include")
+	assert.Equal(t, []string{"BGP", "Interfaces"}, names(mx), "exclude")
+	assert.Equal(t, []string{"BGP", "Firewall", "Interfaces"}, names(ex), "no collector set")
+}
+
+func TestCollectorsScopeLabels(t *testing.T) {
+	c := config.New()
+	f := reflect.ValueOf(&c.Features).Elem()
+	for i := 0; i < f.NumField(); i++ {
+		if f.Field(i).Kind() == reflect.Bool {
+			f.Field(i).SetBool(true)
+		}
+	}
+
+	jc := &junosCollector{
+		collectors: collectorsForDevices([]*connector.Device{{Host: "::1"}}, c, "", interfacelabels.NewDynamicLabels()),
+	}
+
+	labels := prometheus.Labels{"logical_system": "", "routing_instance": ""}
+	err := prometheus.WrapRegistererWith(labels, prometheus.NewRegistry()).Register(jc)
+	assert.NoError(t, err, "labels of logical system and routing instance scrapes must not collide with metric labels")
+}
@@ -4,14 +4,28 @@ package main
 
 import (
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/czerwonk/junos_exporter/internal/config"
 	"github.com/czerwonk/junos_exporter/pkg/connector"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
+var (
+	keyAuthCache   = make(map[keyAuthCacheKey]connector.AuthMethod)
+	keyAuthCacheMu sync.Mutex
+)
+
+type keyAuthCacheKey struct {
+	username      string
+	keyFile       string
+	keyPassphrase string
+}
+
 func devicesForConfig(cfg *config.Config) ([]*connector.Device, error) {
 	if cfg.Devices == nil {
 		if cfg.Targets == nil {
@@ -50,7 +64,7 @@ func devicesFromTargets(targets []string) []*config.DeviceConfig {
 }
 
 func deviceFromDeviceConfig(device *config.DeviceConfig, hostname string, cfg *config.Config) (*connector.Device, error) {
-	auth, err := authForDevice(device, cfg)
+	auth, err := authForDevice(withCredential(device, cfg.CredentialForDevice(device)), cfg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not initialize config for device %s", device.Host)
 	}
@@ -62,12 +76,77 @@ func deviceFromDeviceConfig(device *config.DeviceConfig, hostname string, cfg *c
 		regexp.MustCompile(device.IfDescReg)
 	}
 
+	dev := &connector.Device{
+		Host:       hostname,
+		Port:       device.Port,
+		Auth:       auth,
+		Algorithms: sshAlgorithms(cfg.SSHAlgorithmsForDevice(hostname)),
+	}
+
+	if pj := cfg.ProxyJumpForDevice(hostname); pj != nil {
+		dev.ProxyJump, err = proxyJumpDevice(pj, cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not initialize jump host for device %s", device.Host)
+		}
+	}
+
+	return dev, nil
+}
+
+func proxyJumpDevice(pj *config.ProxyJumpConfig, cfg *config.Config) (*connector.Device, error) {
+	auth, err := authForDevice(&config.DeviceConfig{
+		Username:      pj.Username,
+		Password:      pj.Password,
+		KeyFile:       pj.KeyFile,
+		KeyPassphrase: pj.KeyPassphrase,
+	}, cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &connector.Device{
-		Host: hostname,
-		Auth: auth,
+		Host:       pj.Host,
+		Auth:       auth,
+		Algorithms: sshAlgorithms(cfg.SSHAlgorithms),
 	}, nil
 }
 
+func sshAlgorithms(a *config.SSHAlgorithmsConfig) *connector.Algorithms {
+	if a == nil {
+		return nil
+	}
+
+	return &connector.Algorithms{
+		Ciphers:           a.Ciphers,
+		KeyExchanges:      a.KeyExchanges,
+		MACs:              a.MACs,
+		HostKeyAlgorithms: a.HostKeyAlgorithms,
+	}
+}
+
+// withCredential returns a copy of the device config with credentials not set for the device taken from the credential profile
+func withCredential(device *config.DeviceConfig, cred *config.CredentialConfig) *config.DeviceConfig {
+	if cred == nil {
+		return device
+	}
+
+	d := *device
+	if d.Username == "" {
+		d.Username = cred.Username
+	}
+
+	if d.Password == "" {
+		d.Password = cred.Password
+	}
+
+	if d.KeyFile == "" {
+		d.KeyFile = cred.KeyFile
+		d.KeyPassphrase = cred.KeyPassphrase
+	}
+
+	return &d
+}
+
 func authForDevice(device *config.DeviceConfig, cfg *config.Config) (connector.AuthMethod, error) {
 	user := *sshUsername
 	if device.Username != "" {
@@ -98,6 +177,32 @@ func authForDevice(device *config.DeviceConfig, cfg *config.Config) (connector.A
 }
 
 func authForKeyFile(username, keyFile, keyPassphrase string) (connector.AuthMethod, error) {
+	keyAuthCacheMu.Lock()
+	defer keyAuthCacheMu.Unlock()
+
+	k := keyAuthCacheKey{username: username, keyFile: keyFile, keyPassphrase: keyPassphrase}
+	if auth, found := keyAuthCache[k]; found {
+		return auth, nil
+	}
+
+	auth, err := loadAuthFromKeyFile(username, keyFile, keyPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	keyAuthCache[k] = auth
+	return auth, nil
+}
+
+// resetKeyAuthCache drops all cached keys so changed key files are read again
+func resetKeyAuthCache() {
+	keyAuthCacheMu.Lock()
+	defer keyAuthCacheMu.Unlock()
+
+	keyAuthCache = make(map[keyAuthCacheKey]connector.AuthMethod)
+}
+
+func loadAuthFromKeyFile(username, keyFile, keyPassphrase string) (connector.AuthMethod, error) {
 	f, err := os.Open(keyFile)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not open ssh key file")
@@ -111,3 +216,92 @@ func authForKeyFile(username, keyFile, keyPassphrase string) (connector.AuthMeth
 
 	return auth, nil
 }
+
+// closeConnectionsForChangedDevices closes all connections to devices which were removed from the config
+// or whose connection settings changed. Connections to unchanged devices are kept.
+// If only the credentials of a device changed, the authenticated connection is kept and the new credentials are used when it has to be re-established.
+func closeConnectionsForChangedDevices(connManager *connector.SSHConnectionManager, oldCfg, newCfg *config.Config, newDevices []*connector.Device) {
+	for _, host := range connManager.Hosts() {
+		if sameConnectionSettings(host, oldCfg, newCfg) {
+			continue
+		}
+
+		if d := deviceForHost(newDevices, host); d != nil && sameEndpoint(host, oldCfg, newCfg) {
+			log.Infof("Keeping connection to %s, changed credentials are used on reconnect", host)
+			connManager.UpdateDevice(d)
+			continue
+		}
+
+		log.Infof("Closing connection to %s since its configuration changed", host)
+		connManager.CloseForHost(host)
+	}
+}
+
+// sameConnectionManagerSettings checks if the settings shared by the connections to all devices (SOCKS5 proxy, timeouts, host key verification) are the same in both configs
+func sameConnectionManagerSettings(oldCfg, newCfg *config.Config) bool {
+	return oldCfg.ConnectTimeout == newCfg.ConnectTimeout &&
+		oldCfg.KeepAliveInterval == newCfg.KeepAliveInterval &&
+		reflect.DeepEqual(oldCfg.SOCKS5Proxy, newCfg.SOCKS5Proxy) &&
+		reflect.DeepEqual(oldCfg.KnownHosts, newCfg.KnownHosts)
+}
+
+func deviceForHost(devices []*connector.Device, host string) *connector.Device {
+	for _, d := range devices {
+		if d.Host == host {
+			return d
+		}
+	}
+
+	return nil
+}
+
+func sameConnectionSettings(host string, oldCfg, newCfg *config.Config) bool {
+	return sameEndpoint(host, oldCfg, newCfg) && sameCredentials(host, oldCfg, newCfg)
+}
+
+// sameEndpoint checks if the device is reached the same way (port, jump host, algorithms) in both configs
+func sameEndpoint(host string, oldCfg, newCfg *config.Config) bool {
+	o := oldCfg.FindDeviceConfig(host)
+	n := newCfg.FindDeviceConfig(host)
+
+	if o == nil || n == nil {
+		return false
+	}
+
+	return o.Port == n.Port &&
+		sameProxyJump(oldCfg.ProxyJumpForDevice(host), newCfg.ProxyJumpForDevice(host)) &&
+		reflect.DeepEqual(oldCfg.SSHAlgorithmsForDevice(host), newCfg.SSHAlgorithmsForDevice(host))
+}
+
+// sameCredentials checks if the device is authenticated the same way in both configs
+func sameCredentials(host string, oldCfg, newCfg *config.Config) bool {
+	o := oldCfg.FindDeviceConfig(host)
+	n := newCfg.FindDeviceConfig(host)
+
+	if o == nil || n == nil {
+		return false
+	}
+
+	return oldCfg.Password == newCfg.Password &&
+		o.Username == n.Username &&
+		o.Password == n.Password &&
+		o.KeyFile == n.KeyFile &&
+		o.KeyPassphrase == n.KeyPassphrase &&
+		sameCredential(oldCfg.CredentialForDevice(o), newCfg.CredentialForDevice(n))
+}
+
+func sameCredential(o, n *config.CredentialConfig) bool {
+	if o == nil || n == nil {
+		return o == n
+	}
+
+	return *o == *n
+}
+
+func sameProxyJump(o, n *config.ProxyJumpConfig) bool {
+	if o == nil || n == nil {
+		return o == n
+	}
+
+	return *o == *n
+}
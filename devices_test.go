@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+)
+
+func TestSameConnectionSettings(t *testing.T) {
+	oldCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Username: "user", Password: "secret"},
+			{Host: "router2", Username: "user", Password: "secret"},
+			{Host: "router3", KeyFile: "/path/to/key"},
+		},
+	}
+	newCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Username: "user", Password: "secret"},
+			{Host: "router2", Username: "user", Password: "changed"},
+		},
+	}
+
+	assert.True(t, sameConnectionSettings("router1", oldCfg, newCfg), "unchanged device")
+	assert.False(t, sameConnectionSettings("router2", oldCfg, newCfg), "changed password")
+	assert.False(t, sameConnectionSettings("router3", oldCfg, newCfg), "removed device")
+
+	newCfg.Password = "global"
+	assert.False(t, sameConnectionSettings("router1", oldCfg, newCfg), "changed global password")
+}
+
+func TestSameEndpointAndCredentials(t *testing.T) {
+	oldCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Username: "user", Password: "secret"},
+			{Host: "router2", Username: "user", Password: "secret"},
+		},
+	}
+	newCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Username: "user", Password: "rotated"},
+			{Host: "router2", Username: "user", Password: "secret", Port: 2222},
+		},
+	}
+
+	assert.True(t, sameEndpoint("router1", oldCfg, newCfg), "password rotated")
+	assert.False(t, sameCredentials("router1", oldCfg, newCfg), "password rotated")
+	assert.False(t, sameEndpoint("router2", oldCfg, newCfg), "changed port")
+	assert.True(t, sameCredentials("router2", oldCfg, newCfg), "changed port")
+	assert.False(t, sameEndpoint("router3", oldCfg, newCfg), "unknown device")
+}
+
+func TestSameConnectionSettingsProxyJump(t *testing.T) {
+	oldCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Password: "secret"},
+			{Host: "router2", Password: "secret", ProxyJump: &config.ProxyJumpConfig{Host: "bastion2"}},
+		},
+		ProxyJump: &config.ProxyJumpConfig{Host: "bastion1", Username: "jump"},
+	}
+	newCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Password: "secret"},
+			{Host: "router2", Password: "secret", ProxyJump: &config.ProxyJumpConfig{Host: "bastion3"}},
+		},
+		ProxyJump: &config.ProxyJumpConfig{Host: "bastion1", Username: "jump"},
+	}
+
+	assert.True(t, sameConnectionSettings("router1", oldCfg, newCfg), "unchanged jump host")
+	assert.False(t, sameConnectionSettings("router2", oldCfg, newCfg), "changed device jump host")
+
+	newCfg.ProxyJump = nil
+	assert.False(t, sameConnectionSettings("router1", oldCfg, newCfg), "removed global jump host")
+}
+
+func TestSameConnectionSettingsSSHAlgorithms(t *testing.T) {
+	oldCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Password: "secret"},
+			{Host: "router2", Password: "secret", SSHAlgorithms: &config.SSHAlgorithmsConfig{Ciphers: []string{"aes128-cbc"}}},
+		},
+	}
+	newCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", Password: "secret"},
+			{Host: "router2", Password: "secret", SSHAlgorithms: &config.SSHAlgorithmsConfig{Ciphers: []string{"aes128-ctr"}}},
+		},
+	}
+
+	assert.True(t, sameConnectionSettings("router1", oldCfg, newCfg), "unchanged algorithms")
+	assert.False(t, sameConnectionSettings("router2", oldCfg, newCfg), "changed device ciphers")
+
+	newCfg.SSHAlgorithms = &config.SSHAlgorithmsConfig{MACs: []string{"hmac-sha1"}}
+	assert.False(t, sameConnectionSettings("router1", oldCfg, newCfg), "changed global algorithms")
+}
+
+func TestSameConnectionSettingsCredential(t *testing.T) {
+	oldCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", Credential: "core"},
+		},
+		Credentials: map[string]*config.CredentialConfig{
+			"default": {Username: "user", Password: "secret"},
+			"core":    {Username: "core", Password: "secret"},
+		},
+	}
+	newCfg := &config.Config{
+		Devices: []*config.DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", Credential: "core"},
+		},
+		Credentials: map[string]*config.CredentialConfig{
+			"default": {Username: "user", Password: "secret"},
+			"core":    {Username: "core", Password: "changed"},
+		},
+	}
+
+	assert.True(t, sameConnectionSettings("router1", oldCfg, newCfg), "unchanged default credential")
+	assert.False(t, sameConnectionSettings("router2", oldCfg, newCfg), "changed credential")
+}
+
+func TestWithCredential(t *testing.T) {
+	cred := &config.CredentialConfig{Username: "core", Password: "secret", KeyFile: "/path/to/key", KeyPassphrase: "pass"}
+
+	d := withCredential(&config.DeviceConfig{Host: "router1"}, cred)
+	assert.Equal(t, &config.DeviceConfig{Host: "router1", Username: "core", Password: "secret", KeyFile: "/path/to/key", KeyPassphrase: "pass"}, d)
+
+	d = withCredential(&config.DeviceConfig{Host: "router2", Username: "user", KeyFile: "/other/key"}, cred)
+	assert.Equal(t, &config.DeviceConfig{Host: "router2", Username: "user", Password: "secret", KeyFile: "/other/key"}, d)
+
+	orig := &config.DeviceConfig{Host: "router3"}
+	assert.Same(t, orig, withCredential(orig, nil))
+}
+
+func TestSameConnectionManagerSettings(t *testing.T) {
+	oldCfg := &config.Config{
+		ConnectTimeout: 5 * time.Second,
+		SOCKS5Proxy:    &config.SOCKS5ProxyConfig{Address: "proxy:1080"},
+	}
+	newCfg := &config.Config{
+		ConnectTimeout: 5 * time.Second,
+		SOCKS5Proxy:    &config.SOCKS5ProxyConfig{Address: "proxy:1080"},
+	}
+
+	assert.True(t, sameConnectionManagerSettings(oldCfg, newCfg), "unchanged")
+
+	newCfg.SOCKS5Proxy = &config.SOCKS5ProxyConfig{Address: "proxy:1080", Password: "rotated"}
+	assert.False(t, sameConnectionManagerSettings(oldCfg, newCfg), "changed proxy password")
+
+	newCfg.SOCKS5Proxy = nil
+	assert.False(t, sameConnectionManagerSettings(oldCfg, newCfg), "removed proxy")
+
+	newCfg.SOCKS5Proxy = oldCfg.SOCKS5Proxy
+	newCfg.KeepAliveInterval = 10 * time.Second
+	assert.False(t, sameConnectionManagerSettings(oldCfg, newCfg), "changed keepalive interval")
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
+	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	"github.com/czerwonk/junos_exporter/pkg/scrape"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// dryRun writes the commands a scrape of the target would run to w without connecting to the devices
+func dryRun(w io.Writer, target string) error {
+	if target == "all" {
+		target = ""
+	}
+
+	devs, err := devicesForTarget(target)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devs {
+		scopes := []dryRunScope{{}}
+		if dc := cfg.FindDeviceConfig(d.Host); dc != nil {
+			if cfg.LSEnabled {
+				for _, ls := range dc.LogicalSystems {
+					scopes = append(scopes, dryRunScope{logicalSystem: ls})
+				}
+			}
+
+			for _, ri := range dc.RoutingInstances {
+				scopes = append(scopes, dryRunScope{routingInstance: ri})
+			}
+		}
+
+		for _, s := range scopes {
+			cl := &dryRunClient{
+				device:          d,
+				logicalSystem:   s.logicalSystem,
+				routingInstance: s.routingInstance,
+				cl:              rpc.NewClient(nil, rpc.WithCommandOverrides(cfg.RPCOverridesForDevice(d.Host))),
+			}
+
+			if *dynamicIfaceLabels && s == (dryRunScope{}) {
+				interfacelabels.NewDynamicLabels().CollectDescriptions(d, cl, deviceInterfaceRegex(d.Host))
+			}
+
+			cols := collectorsForDevices([]*connector.Device{d}, cfg, s.logicalSystem, interfacelabels.NewDynamicLabels())
+			if s.routingInstance != "" {
+				cols.restrictTo(routingInstanceCollectors)
+			}
+
+			for _, col := range cols.collectorsForDevice(d) {
+				cl.collector = cols.nameFor(col)
+				dryRunCollector(col, cl)
+			}
+
+			for _, cmd := range cl.commands {
+				fmt.Fprintf(w, "%s\t%s\n", d.Host, cmd)
+			}
+		}
+	}
+
+	return nil
+}
+
+// dryRunScope is a logical system or routing instance of a device scraped separately
+type dryRunScope struct {
+	logicalSystem   string
+	routingInstance string
+}
+
+// dryRunCollector runs the collector against the dry run client, discarding all metrics
+func dryRunCollector(col collector.RPCCollector, cl *dryRunClient) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Warnf("%s: dry run stopped early: %v", col.Name(), r)
+		}
+	}()
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		for range ch {
+		}
+	}()
+	defer close(ch)
+
+	col.Collect(cl, ch, []string{cl.device.Host})
+}
+
+// dryRunClient implements collector.Client, recording the commands instead of running them on the device.
+// Results are left empty, so commands depending on results of previous commands are not recorded.
+type dryRunClient struct {
+	device          *connector.Device
+	logicalSystem   string
+	routingInstance string
+	collector       string
+	cl              *rpc.Client
+	commands        []string
+}
+
+// RunCommandAndParse implements RunCommandAndParse of the collector.Client interface
+func (c *dryRunClient) RunCommandAndParse(cmd string, obj interface{}) error {
+	return c.RunCommandAndParseWithParser(cmd, nil)
+}
+
+// RunCommandAndParseWithParser implements RunCommandAndParseWithParser of the collector.Client interface
+func (c *dryRunClient) RunCommandAndParseWithParser(cmd string, parser rpc.Parser) error {
+	c.commands = append(c.commands, scrape.CommandForCollector(c.cl, c.collector, c.logicalSystem, c.routingInstance, cmd))
+	return nil
+}
+
+// IsSatelliteEnabled implements IsSatelliteEnabled of the collector.Client interface
+func (c *dryRunClient) IsSatelliteEnabled() bool {
+	return cfg.Features.Satellite
+}
+
+// IsScrapingLicenseEnabled implements IsScrapingLicenseEnabled of the collector.Client interface
+func (c *dryRunClient) IsScrapingLicenseEnabled() bool {
+	return cfg.Features.License
+}
+
+// Device implements Device of the collector.Client interface
+func (c *dryRunClient) Device() *connector.Device {
+	return c.device
+}
+
+// LogicalSystem implements LogicalSystem of the collector.Client interface
+func (c *dryRunClient) LogicalSystem() string {
+	return c.logicalSystem
+}
+
+// RoutingInstance implements RoutingInstance of the collector.Client interface
+func (c *dryRunClient) RoutingInstance() string {
+	return c.routingInstance
+}
+
+// Context implements Context of the collector.Client interface
+func (c *dryRunClient) Context() context.Context {
+	return context.Background()
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+)
+
+func TestDryRun(t *testing.T) {
+	oldCfg, oldDevices := cfg, devices
+	defer func() { cfg, devices = oldCfg, oldDevices }()
+
+	cfg = &config.Config{
+		LSEnabled: true,
+		Features: config.FeatureConfig{
+			BGP:     true,
+			Storage: true,
+		},
+		Devices: []*config.DeviceConfig{
+			{Host: "router1", LogicalSystems: []string{"ls1"}},
+			{Host: "router2"},
+		},
+		RPCOverrides: map[string]map[string]string{
+			"bgp": {"show bgp neighbor": "show bgp neighbor instance all"},
+		},
+	}
+	devices = []*connector.Device{{Host: "router1"}, {Host: "router2"}}
+
+	b := &bytes.Buffer{}
+	err := dryRun(b, "router1")
+	assert.NoError(t, err)
+
+	out := b.String()
+	assert.Contains(t, out, "router1\tshow bgp neighbor instance all\n", "override")
+	assert.Contains(t, out, "router1\tshow system storage\n")
+	assert.Contains(t, out, "router1\tshow bgp neighbor instance all logical-system ls1\n", "logical system")
+	assert.NotContains(t, out, "show system storage logical-system ls1", "collector not scoped to logical systems")
+	assert.NotContains(t, out, "router2")
+
+	b.Reset()
+	err = dryRun(b, "all")
+	assert.NoError(t, err)
+	assert.Contains(t, b.String(), "router2\tshow system storage\n", "all targets")
+
+	err = dryRun(b, "router3")
+	assert.Error(t, err, "unknown target")
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	devicesTotalDesc     *prometheus.Desc
+	devicesConnectedDesc *prometheus.Desc
+)
+
+func init() {
+	devicesTotalDesc = prometheus.NewDesc(prefix+"devices_total", "Number of devices configured (devices matched by host patterns are not included)", nil, nil)
+	devicesConnectedDesc = prometheus.NewDesc(prefix+"devices_connected", "Number of configured devices the exporter currently holds a live connection to", nil, nil)
+}
+
+// fleetCollector exports the connection state of all configured devices independent of the scraped targets
+type fleetCollector struct {
+	devices     []*connector.Device
+	connManager *connector.SSHConnectionManager
+}
+
+// Describe implements prometheus.Collector interface
+func (c *fleetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- devicesTotalDesc
+	ch <- devicesConnectedDesc
+}
+
+// Collect implements prometheus.Collector interface
+func (c *fleetCollector) Collect(ch chan<- prometheus.Metric) {
+	connected := 0
+	for _, d := range c.devices {
+		if c.connManager.IsConnected(d) {
+			connected++
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(devicesTotalDesc, prometheus.GaugeValue, float64(len(c.devices)))
+	ch <- prometheus.MustNewConstMetric(devicesConnectedDesc, prometheus.GaugeValue, float64(connected))
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFleetCollector(t *testing.T) {
+	c := &fleetCollector{
+		devices:     []*connector.Device{{Host: "router1"}, {Host: "router2"}},
+		connManager: connector.NewConnectionManager(),
+	}
+
+	ch := make(chan prometheus.Metric, 2)
+	c.Collect(ch)
+	assert.Equal(t, 2, len(ch))
+
+	m := &dto.Metric{}
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, float64(2), m.GetGauge().GetValue(), "devices total")
+
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, float64(0), m.GetGauge().GetValue(), "devices connected")
+}
@@ -3,33 +3,204 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// TransportCLI runs commands using the CLI (| display xml)
+	TransportCLI = "cli"
+
+	// TransportNetconf runs commands using the NETCONF subsystem
+	TransportNetconf = "netconf"
+
+	// KnownHostsStrict fails connections to devices whose host key does not match the known_hosts file
+	KnownHostsStrict = "strict"
+
+	// KnownHostsLenient logs host key mismatches but still connects to the device
+	KnownHostsLenient = "lenient"
+
+	// DefaultCredential is the name of the credential profile used for devices not referencing a profile
+	DefaultCredential = "default"
+)
+
+var (
+	labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// reservedLabels are set by the exporter itself and can not be used as static labels
+	reservedLabels = map[string]bool{
+		"target":           true,
+		"logical_system":   true,
+		"routing_instance": true,
+	}
+)
+
 // Config represents the configuration for the exporter
 type Config struct {
-	Password  string          `yaml:"password"`
-	Targets   []string        `yaml:"targets,omitempty"`
-	Devices   []*DeviceConfig `yaml:"devices,omitempty"`
-	Features  FeatureConfig   `yaml:"features,omitempty"`
-	LSEnabled bool            `yaml:"logical_systems,omitempty"`
-	IfDescReg string          `yaml:"interface_description_regex,omitempty"`
+	Password             string                       `yaml:"password"`
+	PasswordEnv          string                       `yaml:"password_env,omitempty"`
+	PasswordFile         string                       `yaml:"password_file,omitempty"`
+	Targets              []string                     `yaml:"targets,omitempty"`
+	Devices              []*DeviceConfig              `yaml:"devices,omitempty"`
+	Features             FeatureConfig                `yaml:"features,omitempty"`
+	LSEnabled            bool                         `yaml:"logical_systems,omitempty"`
+	IfDescReg            string                       `yaml:"interface_description_regex,omitempty"`
+	ScrapeTimeout        time.Duration                `yaml:"scrape_timeout,omitempty"`
+	ConnectTimeout       time.Duration                `yaml:"connect_timeout,omitempty"`
+	KeepAliveInterval    time.Duration                `yaml:"keepalive_interval,omitempty"`
+	ProxyJump            *ProxyJumpConfig             `yaml:"proxy_jump,omitempty"`
+	Transport            string                       `yaml:"transport,omitempty"`
+	IfFilter             *InterfaceFilterConfig       `yaml:"interface_filter,omitempty"`
+	Credentials          map[string]*CredentialConfig `yaml:"credentials,omitempty"`
+	CacheTTL             time.Duration                `yaml:"cache_ttl,omitempty"`
+	MaxConcurrentTargets int                          `yaml:"max_concurrent_targets,omitempty"`
+	MaxCollectorSeries   int                          `yaml:"max_series_per_collector,omitempty"`
+	RPCOverrides         map[string]map[string]string `yaml:"rpc_overrides,omitempty"`
+	SSHAlgorithms        *SSHAlgorithmsConfig         `yaml:"ssh_algorithms,omitempty"`
+	SOCKS5Proxy          *SOCKS5ProxyConfig           `yaml:"socks5_proxy,omitempty"`
+	KnownHosts           *KnownHostsConfig            `yaml:"known_hosts,omitempty"`
+	GNMI                 *GNMIConfig                  `yaml:"gnmi,omitempty"`
+	CollectorTimeouts    map[string]time.Duration     `yaml:"collector_timeouts,omitempty"`
+	MinIntervals         map[string]time.Duration     `yaml:"collector_min_intervals,omitempty"`
+	Labels               map[string]string            `yaml:"labels,omitempty"`
+	Include              []string                     `yaml:"include,omitempty"`
 }
 
 // DeviceConfig is the config representation of 1 device
 type DeviceConfig struct {
-	Host          string         `yaml:"host"`
-	Username      string         `yaml:"username,omitempty"`
-	Password      string         `yaml:"password,omitempty"`
-	KeyFile       string         `yaml:"key_file,omitempty"`
-	KeyPassphrase string         `yaml:"key_passphrase,omitempty"`
-	Features      *FeatureConfig `yaml:"features,omitempty"`
-	IfDescReg     string         `yaml:"interface_description_regex,omitempty"`
-	IsHostPattern bool           `yaml:"host_pattern,omitempty"`
-	HostPattern   *regexp.Regexp
+	Host              string                       `yaml:"host"`
+	Port              int                          `yaml:"port,omitempty"`
+	Username          string                       `yaml:"username,omitempty"`
+	Password          string                       `yaml:"password,omitempty"`
+	PasswordEnv       string                       `yaml:"password_env,omitempty"`
+	PasswordFile      string                       `yaml:"password_file,omitempty"`
+	KeyFile           string                       `yaml:"key_file,omitempty"`
+	KeyPassphrase     string                       `yaml:"key_passphrase,omitempty"`
+	KeyPassphraseEnv  string                       `yaml:"key_passphrase_env,omitempty"`
+	KeyPassphraseFile string                       `yaml:"key_passphrase_file,omitempty"`
+	Features          *FeatureConfig               `yaml:"features,omitempty"`
+	IfDescReg         string                       `yaml:"interface_description_regex,omitempty"`
+	ScrapeTimeout     time.Duration                `yaml:"scrape_timeout,omitempty"`
+	LogicalSystems    []string                     `yaml:"logical_systems,omitempty"`
+	RoutingInstances  []string                     `yaml:"routing_instances,omitempty"`
+	ProxyJump         *ProxyJumpConfig             `yaml:"proxy_jump,omitempty"`
+	Transport         string                       `yaml:"transport,omitempty"`
+	IfFilter          *InterfaceFilterConfig       `yaml:"interface_filter,omitempty"`
+	Credential        string                       `yaml:"credential,omitempty"`
+	Collectors        *CollectorSetConfig          `yaml:"collectors,omitempty"`
+	RPCOverrides      map[string]map[string]string `yaml:"rpc_overrides,omitempty"`
+	SSHAlgorithms     *SSHAlgorithmsConfig         `yaml:"ssh_algorithms,omitempty"`
+	CollectorTimeouts map[string]time.Duration     `yaml:"collector_timeouts,omitempty"`
+	MinIntervals      map[string]time.Duration     `yaml:"collector_min_intervals,omitempty"`
+	Labels            map[string]string            `yaml:"labels,omitempty"`
+	IsHostPattern     bool                         `yaml:"host_pattern,omitempty"`
+	HostPattern       *regexp.Regexp
+}
+
+// ProxyJumpConfig is the config representation of a jump host the connection to a device is established through
+type ProxyJumpConfig struct {
+	Host              string `yaml:"host"`
+	Username          string `yaml:"username,omitempty"`
+	Password          string `yaml:"password,omitempty"`
+	PasswordEnv       string `yaml:"password_env,omitempty"`
+	PasswordFile      string `yaml:"password_file,omitempty"`
+	KeyFile           string `yaml:"key_file,omitempty"`
+	KeyPassphrase     string `yaml:"key_passphrase,omitempty"`
+	KeyPassphraseEnv  string `yaml:"key_passphrase_env,omitempty"`
+	KeyPassphraseFile string `yaml:"key_passphrase_file,omitempty"`
+}
+
+// SOCKS5ProxyConfig is the config representation of a SOCKS5 proxy the connections to the devices are established through
+type SOCKS5ProxyConfig struct {
+	Address      string `yaml:"address"`
+	Username     string `yaml:"username,omitempty"`
+	Password     string `yaml:"password,omitempty"`
+	PasswordEnv  string `yaml:"password_env,omitempty"`
+	PasswordFile string `yaml:"password_file,omitempty"`
+}
+
+// KnownHostsConfig enables verification of the host keys of devices (and jump hosts) against a known_hosts file
+type KnownHostsConfig struct {
+	File string `yaml:"file"`
+	Mode string `yaml:"mode,omitempty"`
+}
+
+// GNMIConfig is the config representation of the gNMI subscriptions to the devices the gnmi feature is enabled for
+type GNMIConfig struct {
+	Port               int           `yaml:"port,omitempty"`
+	SampleInterval     time.Duration `yaml:"sample_interval,omitempty"`
+	Username           string        `yaml:"username"`
+	Password           string        `yaml:"password,omitempty"`
+	PasswordEnv        string        `yaml:"password_env,omitempty"`
+	PasswordFile       string        `yaml:"password_file,omitempty"`
+	TLS                bool          `yaml:"tls,omitempty"`
+	CAFile             string        `yaml:"ca_file,omitempty"`
+	InsecureSkipVerify bool          `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// SSHAlgorithmsConfig restricts the algorithms negotiated for SSH connections (empty lists use the defaults of the SSH library)
+type SSHAlgorithmsConfig struct {
+	Ciphers           []string `yaml:"ciphers,omitempty"`
+	KeyExchanges      []string `yaml:"key_exchanges,omitempty"`
+	MACs              []string `yaml:"macs,omitempty"`
+	HostKeyAlgorithms []string `yaml:"host_key_algorithms,omitempty"`
+}
+
+// CredentialConfig is a named set of credentials devices can reference
+type CredentialConfig struct {
+	Username          string `yaml:"username,omitempty"`
+	Password          string `yaml:"password,omitempty"`
+	PasswordEnv       string `yaml:"password_env,omitempty"`
+	PasswordFile      string `yaml:"password_file,omitempty"`
+	KeyFile           string `yaml:"key_file,omitempty"`
+	KeyPassphrase     string `yaml:"key_passphrase,omitempty"`
+	KeyPassphraseEnv  string `yaml:"key_passphrase_env,omitempty"`
+	KeyPassphraseFile string `yaml:"key_passphrase_file,omitempty"`
+}
+
+// InterfaceFilterConfig defines regular expressions to include/exclude interfaces by name
+type InterfaceFilterConfig struct {
+	Include []string `yaml:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// CollectorSetConfig restricts the collectors run for a device (names as used in features)
+type CollectorSetConfig struct {
+	Include []string `yaml:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// Allows returns if a collector may run for the device. If include is set only the listed collectors are allowed.
+func (s *CollectorSetConfig) Allows(name string) bool {
+	if s == nil {
+		return true
+	}
+
+	for _, n := range s.Exclude {
+		if n == name {
+			return false
+		}
+	}
+
+	if len(s.Include) == 0 {
+		return true
+	}
+
+	for _, n := range s.Include {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
 }
 
 // FeatureConfig is the list of collectors enabled or disabled
@@ -39,6 +210,7 @@ type FeatureConfig struct {
 	BFD                 bool `yaml:"bfd,omitempty"`
 	BGP                 bool `yaml:"bgp,omitempty"`
 	OSPF                bool `yaml:"ospf,omitempty"`
+	OSPF3               bool `yaml:"ospf3,omitempty"`
 	ISIS                bool `yaml:"isis,omitempty"`
 	NAT                 bool `yaml:"nat,omitempty"`
 	NAT2                bool `yaml:"nat2,omitempty"`
@@ -69,6 +241,24 @@ type FeatureConfig struct {
 	VRRP                bool `yaml:"vrrp,omitempty"`
 	License             bool `yaml:"license,omitempty"`
 	Subscriber          bool `yaml:"subscriber,omitempty"`
+	Users               bool `yaml:"users,omitempty"`
+	FlowMonitoring      bool `yaml:"flow_monitoring,omitempty"`
+	CoS                 bool `yaml:"cos,omitempty"`
+	Fabric              bool `yaml:"fabric,omitempty"`
+	PoE                 bool `yaml:"poe,omitempty"`
+	ARP                 bool `yaml:"arp,omitempty"`
+	Commit              bool `yaml:"commit,omitempty"`
+	BGPDamping          bool `yaml:"bgp_damping,omitempty"`
+	EVPN                bool `yaml:"evpn,omitempty"`
+	NTP                 bool `yaml:"ntp,omitempty"`
+	DHCP                bool `yaml:"dhcp,omitempty"`
+	Multicast           bool `yaml:"multicast,omitempty"`
+	L2VPN               bool `yaml:"l2vpn,omitempty"`
+	RSVP                bool `yaml:"rsvp,omitempty"`
+	Version             bool `yaml:"version,omitempty"`
+	DDoSProtection      bool `yaml:"ddos_protection,omitempty"`
+	StormControl        bool `yaml:"storm_control,omitempty"`
+	GNMI                bool `yaml:"gnmi,omitempty"`
 }
 
 // New creates a new config
@@ -81,19 +271,52 @@ func New() *Config {
 	return c
 }
 
-// Load loads a config from reader
+// Load loads a config from reader. Relative include patterns are resolved against the working directory.
 func Load(reader io.Reader) (*Config, error) {
+	return load(reader, ".", yaml.Unmarshal)
+}
+
+// LoadStrict loads a config from reader. Unknown keys or duplicates are treated as errors.
+func LoadStrict(reader io.Reader) (*Config, error) {
+	return load(reader, ".", yaml.UnmarshalStrict)
+}
+
+// LoadFile loads a config from a file. Relative include patterns are resolved against the directory of the file.
+func LoadFile(path string) (*Config, error) {
+	return loadFile(path, yaml.Unmarshal)
+}
+
+// LoadFileStrict loads a config from a file. Unknown keys or duplicates are treated as errors.
+func LoadFileStrict(path string) (*Config, error) {
+	return loadFile(path, yaml.UnmarshalStrict)
+}
+
+func loadFile(path string, unmarshal func([]byte, interface{}) error) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return load(f, filepath.Dir(path), unmarshal)
+}
+
+func load(reader io.Reader, dir string, unmarshal func([]byte, interface{}) error) (*Config, error) {
 	b, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, err
 	}
 
 	c := New()
-	err = yaml.Unmarshal(b, c)
+	err = unmarshal(b, c)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := includeDevices(c, dir, unmarshal); err != nil {
+		return nil, err
+	}
+
 	for _, device := range c.Devices {
 		if device.IsHostPattern {
 			hostPattern, err := regexp.Compile(device.Host)
@@ -104,9 +327,235 @@ func Load(reader io.Reader) (*Config, error) {
 		}
 	}
 
+	if err := resolveSecrets(c); err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
+// Validate checks the config for invalid values
+func (c *Config) Validate() error {
+	var errs []error
+
+	if len(c.IfDescReg) > 0 {
+		if _, err := regexp.Compile(c.IfDescReg); err != nil {
+			errs = append(errs, fmt.Errorf("invalid interface_description_regex: %w", err))
+		}
+	}
+
+	if !validTransport(c.Transport) {
+		errs = append(errs, fmt.Errorf("invalid transport: %s (expected cli or netconf)", c.Transport))
+	}
+
+	for _, err := range validateInterfaceFilter(c.IfFilter) {
+		errs = append(errs, fmt.Errorf("interface_filter: %w", err))
+	}
+
+	for _, err := range validateRPCOverrides(c.RPCOverrides) {
+		errs = append(errs, fmt.Errorf("rpc_overrides: %w", err))
+	}
+
+	for _, err := range validateSSHAlgorithms(c.SSHAlgorithms) {
+		errs = append(errs, fmt.Errorf("ssh_algorithms: %w", err))
+	}
+
+	for _, err := range validateLabels(c.Labels) {
+		errs = append(errs, fmt.Errorf("labels: %w", err))
+	}
+
+	if c.ConnectTimeout < 0 {
+		errs = append(errs, fmt.Errorf("connect_timeout must not be negative"))
+	}
+
+	if c.KeepAliveInterval < 0 {
+		errs = append(errs, fmt.Errorf("keepalive_interval must not be negative"))
+	}
+
+	if c.MaxConcurrentTargets < 0 {
+		errs = append(errs, fmt.Errorf("max_concurrent_targets must not be negative"))
+	}
+
+	if c.MaxCollectorSeries < 0 {
+		errs = append(errs, fmt.Errorf("max_series_per_collector must not be negative"))
+	}
+
+	if c.ProxyJump != nil && len(c.ProxyJump.Host) == 0 {
+		errs = append(errs, fmt.Errorf("proxy_jump: host must not be empty"))
+	}
+
+	if c.SOCKS5Proxy != nil && len(c.SOCKS5Proxy.Address) == 0 {
+		errs = append(errs, fmt.Errorf("socks5_proxy: address must not be empty"))
+	}
+
+	if c.KnownHosts != nil {
+		if len(c.KnownHosts.File) == 0 {
+			errs = append(errs, fmt.Errorf("known_hosts: file must not be empty"))
+		}
+
+		if !validKnownHostsMode(c.KnownHosts.Mode) {
+			errs = append(errs, fmt.Errorf("known_hosts: invalid mode: %s (expected strict or lenient)", c.KnownHosts.Mode))
+		}
+	}
+
+	if c.GNMI != nil {
+		if len(c.GNMI.Username) == 0 {
+			errs = append(errs, fmt.Errorf("gnmi: username must not be empty"))
+		}
+
+		if c.GNMI.Port < 0 || c.GNMI.Port > 65535 {
+			errs = append(errs, fmt.Errorf("gnmi: invalid port: %d", c.GNMI.Port))
+		}
+
+		if c.GNMI.SampleInterval < 0 {
+			errs = append(errs, fmt.Errorf("gnmi: sample_interval must not be negative"))
+		}
+	}
+
+	hosts := make(map[string]bool)
+	for i, d := range c.Devices {
+		if len(d.Host) == 0 {
+			errs = append(errs, fmt.Errorf("device %d: host must not be empty", i+1))
+			continue
+		}
+
+		if hosts[normalizeHost(d.Host)] {
+			errs = append(errs, fmt.Errorf("device %s: defined multiple times", d.Host))
+		}
+		hosts[normalizeHost(d.Host)] = true
+
+		if len(d.IfDescReg) > 0 {
+			if _, err := regexp.Compile(d.IfDescReg); err != nil {
+				errs = append(errs, fmt.Errorf("device %s: invalid interface_description_regex: %w", d.Host, err))
+			}
+		}
+
+		if len(d.LogicalSystems) > 0 && !c.LSEnabled {
+			errs = append(errs, fmt.Errorf("device %s: logical_systems configured but logical systems are not enabled", d.Host))
+		}
+
+		if !validTransport(d.Transport) {
+			errs = append(errs, fmt.Errorf("device %s: invalid transport: %s (expected cli or netconf)", d.Host, d.Transport))
+		}
+
+		for _, err := range validateInterfaceFilter(d.IfFilter) {
+			errs = append(errs, fmt.Errorf("device %s: interface_filter: %w", d.Host, err))
+		}
+
+		for _, err := range validateRPCOverrides(d.RPCOverrides) {
+			errs = append(errs, fmt.Errorf("device %s: rpc_overrides: %w", d.Host, err))
+		}
+
+		for _, err := range validateSSHAlgorithms(d.SSHAlgorithms) {
+			errs = append(errs, fmt.Errorf("device %s: ssh_algorithms: %w", d.Host, err))
+		}
+
+		for _, err := range validateLabels(d.Labels) {
+			errs = append(errs, fmt.Errorf("device %s: labels: %w", d.Host, err))
+		}
+
+		if len(d.Credential) > 0 && c.Credentials[d.Credential] == nil {
+			errs = append(errs, fmt.Errorf("device %s: credential %s is not defined", d.Host, d.Credential))
+		}
+
+		if d.Port < 0 || d.Port > 65535 {
+			errs = append(errs, fmt.Errorf("device %s: invalid port: %d", d.Host, d.Port))
+		}
+
+		if d.ProxyJump != nil && len(d.ProxyJump.Host) == 0 {
+			errs = append(errs, fmt.Errorf("device %s: proxy_jump: host must not be empty", d.Host))
+		}
+
+		for _, ls := range d.LogicalSystems {
+			if len(ls) == 0 {
+				errs = append(errs, fmt.Errorf("device %s: logical system name must not be empty", d.Host))
+			}
+		}
+
+		for _, ri := range d.RoutingInstances {
+			if len(ri) == 0 {
+				errs = append(errs, fmt.Errorf("device %s: routing instance name must not be empty", d.Host))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateInterfaceFilter(f *InterfaceFilterConfig) []error {
+	if f == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, p := range append(f.Include, f.Exclude...) {
+		if _, err := regexp.Compile(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func validateSSHAlgorithms(a *SSHAlgorithmsConfig) []error {
+	if a == nil {
+		return nil
+	}
+
+	var errs []error
+	for name, algos := range map[string][]string{
+		"ciphers":             a.Ciphers,
+		"key_exchanges":       a.KeyExchanges,
+		"macs":                a.MACs,
+		"host_key_algorithms": a.HostKeyAlgorithms,
+	} {
+		for _, algo := range algos {
+			if len(strings.TrimSpace(algo)) == 0 {
+				errs = append(errs, fmt.Errorf("%s: algorithm name must not be empty", name))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateRPCOverrides(o map[string]map[string]string) []error {
+	var errs []error
+	for name, cmds := range o {
+		for cmd, override := range cmds {
+			if len(strings.TrimSpace(override)) == 0 {
+				errs = append(errs, fmt.Errorf("%s: command replacing '%s' must not be empty", name, cmd))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateLabels(labels map[string]string) []error {
+	var errs []error
+	for name := range labels {
+		if !labelNameRegex.MatchString(name) || strings.HasPrefix(name, "__") {
+			errs = append(errs, fmt.Errorf("invalid label name: %s", name))
+			continue
+		}
+
+		if reservedLabels[name] {
+			errs = append(errs, fmt.Errorf("label %s collides with a label set by the exporter", name))
+		}
+	}
+
+	return errs
+}
+
+func validTransport(t string) bool {
+	return len(t) == 0 || t == TransportCLI || t == TransportNetconf
+}
+
+func validKnownHostsMode(m string) bool {
+	return len(m) == 0 || m == KnownHostsStrict || m == KnownHostsLenient
+}
+
 func setDefaultValues(c *Config) {
 	c.Password = ""
 	c.LSEnabled = false
@@ -117,13 +566,14 @@ func setDefaultValues(c *Config) {
 	f.Environment = true
 	f.Interfaces = true
 	f.InterfaceDiagnostic = true
-	f.InterfaceQueue = true
+	f.InterfaceQueue = false
 	f.IPSec = false
 	f.OSPF = true
+	f.OSPF3 = true
 	f.ISIS = true
 	f.LDP = true
 	f.Routes = true
-	f.Firewall = true
+	f.Firewall = false
 	f.RoutingEngine = true
 	f.Security = false
 	f.SecurityPolicies = false
@@ -141,6 +591,24 @@ func setDefaultValues(c *Config) {
 	f.VRRP = false
 	f.BFD = false
 	f.License = false
+	f.Users = false
+	f.FlowMonitoring = false
+	f.CoS = false
+	f.Fabric = false
+	f.PoE = false
+	f.ARP = false
+	f.Commit = false
+	f.BGPDamping = false
+	f.EVPN = false
+	f.NTP = false
+	f.DHCP = false
+	f.Multicast = false
+	f.L2VPN = false
+	f.RSVP = false
+	f.Version = false
+	f.DDoSProtection = false
+	f.StormControl = false
+	f.GNMI = false
 }
 
 // FeaturesForDevice gets the feature set configured for a device
@@ -154,6 +622,167 @@ func (c *Config) FeaturesForDevice(host string) *FeatureConfig {
 	return &c.Features
 }
 
+// ScrapeTimeoutForDevice gets the scrape timeout configured for a device
+func (c *Config) ScrapeTimeoutForDevice(host string) time.Duration {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && d.ScrapeTimeout > 0 {
+		return d.ScrapeTimeout
+	}
+
+	return c.ScrapeTimeout
+}
+
+// CollectorTimeoutForDevice gets the timeout of a collector (by name as used in features) configured for a device (0 = no collector specific timeout)
+func (c *Config) CollectorTimeoutForDevice(host, collector string) time.Duration {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && d.CollectorTimeouts[collector] > 0 {
+		return d.CollectorTimeouts[collector]
+	}
+
+	return c.CollectorTimeouts[collector]
+}
+
+// MinIntervalForDevice gets the minimum interval between two runs of a collector (by name as used in features) configured for a device (0 = run on every scrape)
+func (c *Config) MinIntervalForDevice(host, collector string) time.Duration {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && d.MinIntervals[collector] > 0 {
+		return d.MinIntervals[collector]
+	}
+
+	return c.MinIntervals[collector]
+}
+
+// ProxyJumpForDevice gets the jump host configured for a device (nil if the device is connected directly)
+func (c *Config) ProxyJumpForDevice(host string) *ProxyJumpConfig {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && d.ProxyJump != nil {
+		return d.ProxyJump
+	}
+
+	return c.ProxyJump
+}
+
+// TransportForDevice gets the transport (cli or netconf) used to run commands on a device
+func (c *Config) TransportForDevice(host string) string {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && len(d.Transport) > 0 {
+		return d.Transport
+	}
+
+	if len(c.Transport) > 0 {
+		return c.Transport
+	}
+
+	return TransportCLI
+}
+
+// InterfaceFilterForDevice gets the interface filter configured for a device (nil if all interfaces are collected)
+func (c *Config) InterfaceFilterForDevice(host string) *InterfaceFilterConfig {
+	d := c.FindDeviceConfig(host)
+
+	if d != nil && d.IfFilter != nil {
+		return d.IfFilter
+	}
+
+	return c.IfFilter
+}
+
+// CredentialForDevice gets the credential profile referenced by a device (nil if no profile applies)
+func (c *Config) CredentialForDevice(d *DeviceConfig) *CredentialConfig {
+	name := DefaultCredential
+	if d != nil && len(d.Credential) > 0 {
+		name = d.Credential
+	}
+
+	return c.Credentials[name]
+}
+
+// CollectorSetForDevice gets the collectors allowed/denied for a device (nil if all enabled collectors are run)
+func (c *Config) CollectorSetForDevice(host string) *CollectorSetConfig {
+	d := c.FindDeviceConfig(host)
+	if d == nil {
+		return nil
+	}
+
+	return d.Collectors
+}
+
+// RPCOverridesForDevice gets the commands replacing the default commands of the collectors (keyed by collector name and default command).
+// Overrides of a device take precedence over the global ones.
+func (c *Config) RPCOverridesForDevice(host string) map[string]map[string]string {
+	d := c.FindDeviceConfig(host)
+	if d == nil || len(d.RPCOverrides) == 0 {
+		return c.RPCOverrides
+	}
+
+	overrides := make(map[string]map[string]string)
+	for _, o := range []map[string]map[string]string{c.RPCOverrides, d.RPCOverrides} {
+		for name, cmds := range o {
+			if overrides[name] == nil {
+				overrides[name] = make(map[string]string)
+			}
+
+			for cmd, override := range cmds {
+				overrides[name][cmd] = override
+			}
+		}
+	}
+
+	return overrides
+}
+
+// LabelsForDevice gets the static labels added to all metrics of a device.
+// Labels of a device take precedence over the global ones.
+func (c *Config) LabelsForDevice(host string) map[string]string {
+	d := c.FindDeviceConfig(host)
+	if d == nil || len(d.Labels) == 0 {
+		return c.Labels
+	}
+
+	labels := make(map[string]string)
+	for _, l := range []map[string]string{c.Labels, d.Labels} {
+		for name, value := range l {
+			labels[name] = value
+		}
+	}
+
+	return labels
+}
+
+// SSHAlgorithmsForDevice gets the SSH algorithms configured for a device (nil if the defaults are used).
+// Each list of the device takes precedence over the global one.
+func (c *Config) SSHAlgorithmsForDevice(host string) *SSHAlgorithmsConfig {
+	d := c.FindDeviceConfig(host)
+	if d == nil || d.SSHAlgorithms == nil {
+		return c.SSHAlgorithms
+	}
+
+	if c.SSHAlgorithms == nil {
+		return d.SSHAlgorithms
+	}
+
+	a := *c.SSHAlgorithms
+	if len(d.SSHAlgorithms.Ciphers) > 0 {
+		a.Ciphers = d.SSHAlgorithms.Ciphers
+	}
+	if len(d.SSHAlgorithms.KeyExchanges) > 0 {
+		a.KeyExchanges = d.SSHAlgorithms.KeyExchanges
+	}
+	if len(d.SSHAlgorithms.MACs) > 0 {
+		a.MACs = d.SSHAlgorithms.MACs
+	}
+	if len(d.SSHAlgorithms.HostKeyAlgorithms) > 0 {
+		a.HostKeyAlgorithms = d.SSHAlgorithms.HostKeyAlgorithms
+	}
+
+	return &a
+}
+
 func (c *Config) FindDeviceConfig(host string) *DeviceConfig {
 	for _, dc := range c.Devices {
 		if dc.HostPattern != nil {
@@ -161,7 +790,7 @@ func (c *Config) FindDeviceConfig(host string) *DeviceConfig {
 				return dc
 			}
 		} else {
-			if dc.Host == host {
+			if SameHost(dc.Host, host) {
 				return dc
 			}
 		}
@@ -5,8 +5,11 @@ package config
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -56,17 +59,18 @@ func TestShouldUseDefaults(t *testing.T) {
 
 	assertFeature("BGP", c.Features.BGP, true, t)
 	assertFeature("OSPF", c.Features.OSPF, true, t)
+	assertFeature("OSPF3", c.Features.OSPF3, true, t)
 	assertFeature("ISIS", c.Features.ISIS, true, t)
 	assertFeature("Routes", c.Features.Routes, true, t)
 	assertFeature("RoutingEngine", c.Features.RoutingEngine, true, t)
 	assertFeature("Environment", c.Features.Environment, true, t)
-	assertFeature("Firewall", c.Features.Firewall, true, t)
+	assertFeature("Firewall", c.Features.Firewall, false, t)
 	assertFeature("InterfaceDiagnostic", c.Features.InterfaceDiagnostic, true, t)
 	assertFeature("Interfaces", c.Features.Interfaces, true, t)
 	assertFeature("L2Circuit", c.Features.L2Circuit, false, t)
 	assertFeature("Storage", c.Features.Storage, false, t)
 	assertFeature("FPC", c.Features.FPC, false, t)
-	assertFeature("InterfaceQueue", c.Features.InterfaceQueue, true, t)
+	assertFeature("InterfaceQueue", c.Features.InterfaceQueue, false, t)
 	assertFeature("IPSec", c.Features.IPSec, false, t)
 	assertFeature("Accounting", c.Features.Accounting, false, t)
 	assertFeature("Power", c.Features.Power, false, t)
@@ -210,3 +214,268 @@ func TestFindDeviceConfig(t *testing.T) {
 		t.Fatal("Unexpected device for switch-oob")
 	}
 }
+
+func TestFindDeviceConfigIPv6(t *testing.T) {
+	c := &Config{
+		Devices: []*DeviceConfig{
+			{Host: "2001:db8::1", Username: "plain"},
+			{Host: "[2001:db8::2]:830", Username: "port"},
+		},
+	}
+
+	assert.Equal(t, "plain", c.FindDeviceConfig("[2001:db8::1]").Username, "brackets")
+	assert.Equal(t, "plain", c.FindDeviceConfig("2001:DB8:0::1").Username, "notation")
+	assert.Equal(t, "port", c.FindDeviceConfig("[2001:db8::2]:830").Username, "with port")
+	assert.Nil(t, c.FindDeviceConfig("[2001:db8::2]:22"), "other port")
+	assert.Nil(t, c.FindDeviceConfig("2001:db8::3"), "unknown")
+}
+
+func TestSameHost(t *testing.T) {
+	assert.True(t, SameHost("router1", "router1"))
+	assert.True(t, SameHost("router1:22", "router1:22"))
+	assert.False(t, SameHost("router1:22", "router1:830"))
+	assert.True(t, SameHost("[2001:db8::1]", "2001:db8::1"))
+	assert.True(t, SameHost("[2001:db8:0:0::1]:830", "[2001:db8::1]:830"))
+	assert.False(t, SameHost("2001:db8::1", "2001:db8::2"))
+	assert.True(t, SameHost("192.0.2.1", "192.0.2.1"))
+}
+
+func TestScrapeTimeoutForDevice(t *testing.T) {
+	b, err := os.ReadFile("tests/config7.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 30*time.Second, c.ScrapeTimeoutForDevice("router1"), "global timeout")
+	assert.Equal(t, 5*time.Second, c.ScrapeTimeoutForDevice("router2"), "device timeout")
+	assert.Equal(t, 30*time.Second, c.ScrapeTimeoutForDevice("router3"), "unknown device")
+
+	assert.Equal(t, 20*time.Second, c.CollectorTimeoutForDevice("router1", "routes"), "global collector timeout")
+	assert.Equal(t, 2*time.Second, c.CollectorTimeoutForDevice("router2", "routes"), "device collector timeout")
+	assert.Equal(t, 10*time.Second, c.CollectorTimeoutForDevice("router2", "bgp"), "global collector timeout (device)")
+	assert.Equal(t, time.Duration(0), c.CollectorTimeoutForDevice("router1", "ospf"), "no collector timeout")
+
+	assert.Equal(t, 5*time.Minute, c.MinIntervalForDevice("router1", "routes"), "global min interval")
+	assert.Equal(t, time.Minute, c.MinIntervalForDevice("router2", "routes"), "device min interval")
+	assert.Equal(t, 10*time.Minute, c.MinIntervalForDevice("router2", "interface_diagnostic"), "device min interval")
+	assert.Equal(t, time.Duration(0), c.MinIntervalForDevice("router1", "bgp"), "no min interval")
+}
+
+func TestTransportForDevice(t *testing.T) {
+	c := &Config{
+		Devices: []*DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", Transport: TransportNetconf},
+		},
+	}
+
+	assert.Equal(t, TransportCLI, c.TransportForDevice("router1"), "default")
+	assert.Equal(t, TransportNetconf, c.TransportForDevice("router2"), "device specific")
+
+	c.Transport = TransportNetconf
+	assert.Equal(t, TransportNetconf, c.TransportForDevice("router1"), "global")
+}
+
+func TestCredentialForDevice(t *testing.T) {
+	c := &Config{
+		Credentials: map[string]*CredentialConfig{
+			"core": {Username: "core"},
+		},
+	}
+
+	assert.Nil(t, c.CredentialForDevice(&DeviceConfig{Host: "router1"}), "no default profile")
+	assert.Equal(t, "core", c.CredentialForDevice(&DeviceConfig{Host: "router2", Credential: "core"}).Username, "referenced profile")
+
+	c.Credentials[DefaultCredential] = &CredentialConfig{Username: "default"}
+	assert.Equal(t, "default", c.CredentialForDevice(&DeviceConfig{Host: "router1"}).Username, "default profile")
+}
+
+func TestRPCOverridesForDevice(t *testing.T) {
+	c := &Config{
+		RPCOverrides: map[string]map[string]string{
+			"bgp": {"show bgp neighbor": "show bgp neighbor exact-instance master"},
+		},
+		Devices: []*DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", RPCOverrides: map[string]map[string]string{
+				"bgp": {"show bgp neighbor": "show bgp neighbor instance all"},
+				"ldp": {"show ldp neighbor": "show ldp neighbor extensive"},
+			}},
+		},
+	}
+
+	assert.Equal(t, "show bgp neighbor exact-instance master", c.RPCOverridesForDevice("router1")["bgp"]["show bgp neighbor"], "global")
+	assert.Equal(t, "show bgp neighbor exact-instance master", c.RPCOverridesForDevice("router3")["bgp"]["show bgp neighbor"], "unknown device")
+
+	o := c.RPCOverridesForDevice("router2")
+	assert.Equal(t, "show bgp neighbor instance all", o["bgp"]["show bgp neighbor"], "device specific")
+	assert.Equal(t, "show ldp neighbor extensive", o["ldp"]["show ldp neighbor"], "device specific")
+	assert.Equal(t, "show bgp neighbor exact-instance master", c.RPCOverrides["bgp"]["show bgp neighbor"], "global unchanged")
+}
+
+func TestLabelsForDevice(t *testing.T) {
+	c := &Config{
+		Labels: map[string]string{"region": "eu", "role": "edge"},
+		Devices: []*DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", Labels: map[string]string{"role": "core", "pop": "fra1"}},
+		},
+	}
+
+	assert.Equal(t, map[string]string{"region": "eu", "role": "edge"}, c.LabelsForDevice("router1"), "global")
+	assert.Equal(t, map[string]string{"region": "eu", "role": "edge"}, c.LabelsForDevice("router3"), "unknown device")
+	assert.Equal(t, map[string]string{"region": "eu", "role": "core", "pop": "fra1"}, c.LabelsForDevice("router2"), "device specific")
+	assert.Equal(t, "edge", c.Labels["role"], "global unchanged")
+}
+
+func TestLoadShouldResolveSecrets(t *testing.T) {
+	t.Setenv("JUNOS_EXPORTER_TEST_PW", "from-env")
+
+	f := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(f, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load(strings.NewReader(`
+password_env: JUNOS_EXPORTER_TEST_PW
+devices:
+  - host: router1
+    password_file: ` + f + `
+  - host: router2
+    key_file: /path/to/key
+    key_passphrase_env: JUNOS_EXPORTER_TEST_PW
+socks5_proxy:
+  address: proxy.example.com:1080
+  username: exporter
+  password_file: ` + f + `
+gnmi:
+  username: exporter
+  password_env: JUNOS_EXPORTER_TEST_PW
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", c.Password)
+	assert.Equal(t, "from-file", c.Devices[0].Password)
+	assert.Equal(t, "from-env", c.Devices[1].KeyPassphrase)
+	assert.Equal(t, "from-file", c.SOCKS5Proxy.Password)
+	assert.Equal(t, "from-env", c.GNMI.Password)
+
+	_, err = Load(strings.NewReader("password_env: JUNOS_EXPORTER_TEST_UNSET\n"))
+	assert.ErrorContains(t, err, "environment variable JUNOS_EXPORTER_TEST_UNSET is not set")
+
+	_, err = Load(strings.NewReader("devices:\n  - host: router1\n    password_file: /does/not/exist\n"))
+	assert.ErrorContains(t, err, "device router1: password")
+}
+
+func TestLoadStrictShouldFailOnUnknownKeys(t *testing.T) {
+	b, err := os.ReadFile("tests/config8.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Load(bytes.NewReader(b))
+	assert.NoError(t, err, "Load")
+
+	_, err = LoadStrict(bytes.NewReader(b))
+	assert.ErrorContains(t, err, "field bgb not found")
+}
+
+func TestLoadFileShouldIncludeDevices(t *testing.T) {
+	c, err := LoadFile("tests/config10.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 4, len(c.Devices), "devices")
+	assert.Equal(t, "router1", c.Devices[0].Host, "devices of the config file first")
+	assert.Equal(t, "router2", c.Devices[1].Host)
+	assert.True(t, c.FeaturesForDevice("router3").ISIS, "included device config")
+	assert.NotNil(t, c.FindDeviceConfig("switch1"), "included host pattern")
+	assert.NoError(t, c.Validate())
+}
+
+func TestLoadFileShouldFailOnConflictingIncludes(t *testing.T) {
+	_, err := LoadFile("tests/config11.yml")
+	assert.ErrorContains(t, err, "device router2 is already defined in tests/include/region1.yml")
+}
+
+func TestSSHAlgorithmsForDevice(t *testing.T) {
+	c := &Config{
+		Devices: []*DeviceConfig{
+			{Host: "router1"},
+			{Host: "router2", SSHAlgorithms: &SSHAlgorithmsConfig{
+				Ciphers: []string{"aes128-cbc"},
+			}},
+		},
+	}
+
+	assert.Nil(t, c.SSHAlgorithmsForDevice("router1"), "defaults")
+	assert.Equal(t, []string{"aes128-cbc"}, c.SSHAlgorithmsForDevice("router2").Ciphers, "device specific")
+
+	c.SSHAlgorithms = &SSHAlgorithmsConfig{
+		Ciphers: []string{"aes256-gcm@openssh.com"},
+		MACs:    []string{"hmac-sha2-256"},
+	}
+	assert.Equal(t, c.SSHAlgorithms, c.SSHAlgorithmsForDevice("router1"), "global")
+	assert.Equal(t, c.SSHAlgorithms, c.SSHAlgorithmsForDevice("router3"), "unknown device")
+
+	a := c.SSHAlgorithmsForDevice("router2")
+	assert.Equal(t, []string{"aes128-cbc"}, a.Ciphers, "device specific")
+	assert.Equal(t, []string{"hmac-sha2-256"}, a.MACs, "global fallback")
+	assert.Equal(t, []string{"aes256-gcm@openssh.com"}, c.SSHAlgorithms.Ciphers, "global unchanged")
+}
+
+func TestValidate(t *testing.T) {
+	b, err := os.ReadFile("tests/config9.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.Validate()
+	assert.ErrorContains(t, err, "invalid interface_description_regex")
+	assert.ErrorContains(t, err, "device router1: defined multiple times")
+	assert.ErrorContains(t, err, "device router1: invalid interface_description_regex")
+	assert.ErrorContains(t, err, "device 3: host must not be empty")
+	assert.ErrorContains(t, err, "device router2: logical_systems configured but logical systems are not enabled")
+	assert.ErrorContains(t, err, "device router3: invalid transport: soap")
+	assert.ErrorContains(t, err, "device router4: interface_filter:")
+	assert.ErrorContains(t, err, "device router5: credential unknown is not defined")
+	assert.ErrorContains(t, err, "max_concurrent_targets must not be negative")
+	assert.ErrorContains(t, err, "connect_timeout must not be negative")
+	assert.ErrorContains(t, err, "keepalive_interval must not be negative")
+	assert.ErrorContains(t, err, "max_series_per_collector must not be negative")
+	assert.ErrorContains(t, err, "rpc_overrides: bgp: command replacing 'show bgp neighbor' must not be empty")
+	assert.ErrorContains(t, err, "device router6: rpc_overrides: ldp: command replacing 'show ldp neighbor' must not be empty")
+	assert.ErrorContains(t, err, "socks5_proxy: address must not be empty")
+	assert.ErrorContains(t, err, "known_hosts: file must not be empty")
+	assert.ErrorContains(t, err, "known_hosts: invalid mode: ignore (expected strict or lenient)")
+	assert.ErrorContains(t, err, "gnmi: username must not be empty")
+	assert.ErrorContains(t, err, "gnmi: invalid port: 70000")
+	assert.ErrorContains(t, err, "gnmi: sample_interval must not be negative")
+	assert.ErrorContains(t, err, "device router7: ssh_algorithms: macs: algorithm name must not be empty")
+	assert.ErrorContains(t, err, "device router8: routing instance name must not be empty")
+	assert.ErrorContains(t, err, "device router9: invalid port: 70000")
+	assert.ErrorContains(t, err, "device router10: labels: label target collides with a label set by the exporter")
+	assert.ErrorContains(t, err, "device router10: labels: invalid label name: 1role")
+
+	b, err = os.ReadFile("tests/config3.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = Load(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, c.Validate())
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// SameHost returns if both hosts (hostname or IP address with an optional port) refer to the same device.
+// IPv6 addresses are compared regardless of brackets and notation (e.g. [2001:DB8:0::1] equals 2001:db8::1).
+func SameHost(a, b string) bool {
+	return normalizeHost(a) == normalizeHost(b)
+}
+
+func normalizeHost(host string) string {
+	h, p, err := net.SplitHostPort(host)
+	if err != nil {
+		h = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+		p = ""
+	}
+
+	if addr, err := netip.ParseAddr(h); err == nil {
+		h = addr.String()
+	}
+
+	if len(p) == 0 {
+		return h
+	}
+
+	return net.JoinHostPort(h, p)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// includeFile is the content of a file included by the config
+type includeFile struct {
+	Devices []*DeviceConfig `yaml:"devices,omitempty"`
+}
+
+// includeDevices appends the devices of all files matching the include patterns of the config.
+// Files are read in lexical order, a host defined in more than one file is treated as an error.
+func includeDevices(c *Config, dir string, unmarshal func([]byte, interface{}) error) error {
+	definedIn := make(map[string]string)
+	for _, d := range c.Devices {
+		definedIn[normalizeHost(d.Host)] = "the config file"
+	}
+
+	for _, pattern := range c.Include {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(dir, pattern)
+		}
+
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("include %s: %w", pattern, err)
+		}
+
+		for _, file := range files {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("include %s: %w", file, err)
+			}
+
+			inc := &includeFile{}
+			if err := unmarshal(b, inc); err != nil {
+				return fmt.Errorf("include %s: %w", file, err)
+			}
+
+			for _, d := range inc.Devices {
+				host := normalizeHost(d.Host)
+				if other, found := definedIn[host]; found {
+					return fmt.Errorf("include %s: device %s is already defined in %s", file, d.Host, other)
+				}
+
+				definedIn[host] = file
+				c.Devices = append(c.Devices, d)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// resolveSecrets replaces passwords and key passphrases referencing an environment variable (*_env) or a file (*_file) with their values
+func resolveSecrets(c *Config) error {
+	if err := resolveSecret(&c.Password, c.PasswordEnv, c.PasswordFile); err != nil {
+		return fmt.Errorf("password: %w", err)
+	}
+
+	if err := resolveProxyJumpSecrets(c.ProxyJump); err != nil {
+		return fmt.Errorf("proxy_jump: %w", err)
+	}
+
+	if c.SOCKS5Proxy != nil {
+		if err := resolveSecret(&c.SOCKS5Proxy.Password, c.SOCKS5Proxy.PasswordEnv, c.SOCKS5Proxy.PasswordFile); err != nil {
+			return fmt.Errorf("socks5_proxy: password: %w", err)
+		}
+	}
+
+	if c.GNMI != nil {
+		if err := resolveSecret(&c.GNMI.Password, c.GNMI.PasswordEnv, c.GNMI.PasswordFile); err != nil {
+			return fmt.Errorf("gnmi: password: %w", err)
+		}
+	}
+
+	for name, cred := range c.Credentials {
+		if cred == nil {
+			continue
+		}
+
+		if err := resolveSecret(&cred.Password, cred.PasswordEnv, cred.PasswordFile); err != nil {
+			return fmt.Errorf("credential %s: password: %w", name, err)
+		}
+
+		if err := resolveSecret(&cred.KeyPassphrase, cred.KeyPassphraseEnv, cred.KeyPassphraseFile); err != nil {
+			return fmt.Errorf("credential %s: key_passphrase: %w", name, err)
+		}
+	}
+
+	for _, d := range c.Devices {
+		if err := resolveSecret(&d.Password, d.PasswordEnv, d.PasswordFile); err != nil {
+			return fmt.Errorf("device %s: password: %w", d.Host, err)
+		}
+
+		if err := resolveSecret(&d.KeyPassphrase, d.KeyPassphraseEnv, d.KeyPassphraseFile); err != nil {
+			return fmt.Errorf("device %s: key_passphrase: %w", d.Host, err)
+		}
+
+		if err := resolveProxyJumpSecrets(d.ProxyJump); err != nil {
+			return fmt.Errorf("device %s: proxy_jump: %w", d.Host, err)
+		}
+	}
+
+	return nil
+}
+
+func resolveProxyJumpSecrets(pj *ProxyJumpConfig) error {
+	if pj == nil {
+		return nil
+	}
+
+	if err := resolveSecret(&pj.Password, pj.PasswordEnv, pj.PasswordFile); err != nil {
+		return fmt.Errorf("password: %w", err)
+	}
+
+	if err := resolveSecret(&pj.KeyPassphrase, pj.KeyPassphraseEnv, pj.KeyPassphraseFile); err != nil {
+		return fmt.Errorf("key_passphrase: %w", err)
+	}
+
+	return nil
+}
+
+func resolveSecret(value *string, env, file string) error {
+	if len(env) > 0 && len(file) > 0 {
+		return fmt.Errorf("environment variable and file must not be set both")
+	}
+
+	if len(env) > 0 {
+		v, found := os.LookupEnv(env)
+		if !found {
+			return fmt.Errorf("environment variable %s is not set", env)
+		}
+
+		*value = v
+	}
+
+	if len(file) > 0 {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		*value = strings.TrimRight(string(b), "\r\n")
+	}
+
+	return nil
+}
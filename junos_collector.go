@@ -4,77 +4,139 @@ package main
 
 import (
 	"context"
+	"errors"
 	"regexp"
-	"sync"
 	"time"
 
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/czerwonk/junos_exporter/pkg/connector"
 	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
 	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	"github.com/czerwonk/junos_exporter/pkg/scrape"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
-	"go.opentelemetry.io/otel/trace"
 )
 
 const prefix = "junos_"
 
 var (
-	scrapeCollectorDurationDesc *prometheus.Desc
-	scrapeDurationDesc          *prometheus.Desc
-	upDesc                      *prometheus.Desc
-	defaultIfDescReg            *regexp.Regexp
+	sshConnectionUptimeDesc *prometheus.Desc
+	sshReconnectsDesc       *prometheus.Desc
+	defaultIfDescReg        *regexp.Regexp
 )
 
 func init() {
-	upDesc = prometheus.NewDesc(prefix+"up", "Scrape of target was successful", []string{"target"}, nil)
-	scrapeDurationDesc = prometheus.NewDesc(prefix+"collector_duration_seconds", "Duration of a collector scrape for one target", []string{"target"}, nil)
-	scrapeCollectorDurationDesc = prometheus.NewDesc(prefix+"collect_duration_seconds", "Duration of a scrape by collector and target", []string{"target", "collector"}, nil)
+	sshConnectionUptimeDesc = prometheus.NewDesc(prefix+"ssh_connection_uptime_seconds", "Duration since the current SSH connection to the target was established", []string{"target"}, nil)
+	sshReconnectsDesc = prometheus.NewDesc(prefix+"ssh_reconnects_total", "Number of times a new SSH connection had to be established after the previous one was lost", []string{"target"}, nil)
 	defaultIfDescReg = regexp.MustCompile(`\[([^=\]]+)(=[^\]]+)?\]`)
 }
 
 type junosCollector struct {
-	devices    []*connector.Device
-	clients    map[*connector.Device]*rpc.Client
-	collectors *collectors
-	ctx        context.Context
+	targets         []*scrape.Target
+	scraper         *scrape.Scraper
+	collectors      *collectors
+	logicalSystem   string
+	routingInstance string
+	staticLabels    bool
+	ctx             context.Context
 }
 
-func newJunosCollector(ctx context.Context, devices []*connector.Device, logicalSystem string) *junosCollector {
+func newJunosCollector(ctx context.Context, devices []*connector.Device, logicalSystem, routingInstance string, collectorFilter map[string]bool) *junosCollector {
 	l := interfacelabels.NewDynamicLabels()
 
 	clients := make(map[*connector.Device]*rpc.Client)
 
 	for _, d := range devices {
 		cl, err := clientForDevice(d, connManager)
+		if errors.Is(err, connector.ErrConnectBackoff) {
+			log.WithField("host", d.Host).Debugf("Not connecting to %s: %s", d, err)
+			continue
+		}
 		if err != nil {
-			log.Errorf("Could not connect to %s: %s", d, err)
+			log.WithField("host", d.Host).Errorf("Could not connect to %s: %s", d, err)
 			continue
 		}
 
 		clients[d] = cl
-		cta := &clientTracingAdapter{
-			cl:  cl,
-			ctx: ctx,
-		}
 
-		if *dynamicIfaceLabels {
-			regex := deviceInterfaceRegex(d.Host)
-			err = l.CollectDescriptions(d, cta, regex)
+		// interface collectors are not scoped to logical systems or routing instances, so the descriptions are only needed for the default one
+		if *dynamicIfaceLabels && logicalSystem == "" && routingInstance == "" {
+			err = collectInterfaceDescriptions(ctx, d, cl, l)
 			if err != nil {
-				log.Errorf("Could not get interface descriptions %s: %s", d, err)
+				log.WithField("host", d.Host).Errorf("Could not get interface descriptions %s: %s", d, err)
 				continue
 			}
 		}
 	}
 
-	return &junosCollector{
-		devices:    devices,
-		collectors: collectorsForDevices(devices, cfg, logicalSystem, l),
-		clients:    clients,
-		ctx:        ctx,
+	cols := collectorsForDevices(devices, cfg, logicalSystem, l)
+	if routingInstance != "" {
+		cols.restrictTo(routingInstanceCollectors)
+	}
+
+	if collectorFilter != nil {
+		cols.restrictTo(collectorFilter)
+	}
+
+	c := &junosCollector{
+		collectors:      cols,
+		logicalSystem:   logicalSystem,
+		routingInstance: routingInstance,
+		staticLabels:    hasStaticLabels(cfg, devices),
+		ctx:             ctx,
+	}
+
+	c.scraper = scrape.New(
+		scrape.WithLogicalSystem(logicalSystem),
+		scrape.WithRoutingInstance(routingInstance),
+		scrape.WithMaxConcurrentTargets(cfg.MaxConcurrentTargets),
+		scrape.WithMaxSeriesPerCollector(cfg.MaxCollectorSeries),
+		scrape.WithCollectWrapper(c.collectCached),
+		scrape.WithTargetCollected(c.targetCollected),
+	)
+
+	for _, d := range devices {
+		t := &scrape.Target{
+			Device:  d,
+			Timeout: cfg.ScrapeTimeoutForDevice(d.Host),
+			Labels:  cfg.LabelsForDevice(d.Host),
+		}
+
+		if cl, found := clients[d]; found {
+			t.Client = cl
+		}
+
+		for _, col := range cols.collectorsForDevice(d) {
+			name := cols.nameFor(col)
+			t.Collectors = append(t.Collectors, &scrape.Collector{
+				RPCCollector: col,
+				Key:          name,
+				Timeout:      cfg.CollectorTimeoutForDevice(d.Host, name),
+			})
+		}
+
+		c.targets = append(c.targets, t)
+	}
+
+	return c
+}
+
+// collectInterfaceDescriptions retrieves the interface descriptions of a device, bounded by the scrape timeout of the device
+func collectInterfaceDescriptions(ctx context.Context, d *connector.Device, cl *rpc.Client, l *interfacelabels.DynamicLabels) error {
+	if timeout := cfg.ScrapeTimeoutForDevice(d.Host); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cta := scrape.NewClient(ctx, cl)
+	regex := deviceInterfaceRegex(d.Host)
+	if descriptionCache != nil {
+		return l.CollectDescriptionsCached(d, cta, regex, descriptionCache)
 	}
+
+	return l.CollectDescriptions(d, cta, regex)
 }
 
 func deviceInterfaceRegex(host string) *regexp.Regexp {
@@ -120,15 +182,39 @@ func clientForDevice(device *connector.Device, connManager *connector.SSHConnect
 		opts = append(opts, rpc.WithLicenseInformation())
 	}
 
+	if cfg.TransportForDevice(device.Host) == config.TransportNetconf {
+		opts = append(opts, rpc.WithNetconf())
+	}
+
+	if *rpcMaxRetries > 0 {
+		opts = append(opts, rpc.WithRetries(*rpcMaxRetries, *rpcRetryBackoff))
+	}
+
+	if overrides := cfg.RPCOverridesForDevice(device.Host); len(overrides) > 0 {
+		opts = append(opts, rpc.WithCommandOverrides(overrides))
+	}
+
+	if auditLog != nil {
+		opts = append(opts, rpc.WithAuditLog(auditLog))
+	}
+
 	c := rpc.NewClient(conn, opts...)
 	return c, nil
 }
 
 // Describe implements prometheus.Collector interface
 func (c *junosCollector) Describe(ch chan<- *prometheus.Desc) {
-	ch <- upDesc
-	ch <- scrapeDurationDesc
-	ch <- scrapeCollectorDurationDesc
+	// static labels differ between the devices, so the collector is registered unchecked (collisions are rejected on config load)
+	if c.staticLabels {
+		return
+	}
+
+	scrape.New().Describe(ch)
+	ch <- sshConnectionUptimeDesc
+	ch <- sshReconnectsDesc
+	ch <- cacheHitsDesc
+	ch <- cacheMissesDesc
+	ch <- lastScrapeSuccessDesc
 
 	for _, col := range c.collectors.allEnabledCollectors() {
 		col.Describe(ch)
@@ -137,62 +223,59 @@ func (c *junosCollector) Describe(ch chan<- *prometheus.Desc) {
 
 // Collect implements prometheus.Collector interface
 func (c *junosCollector) Collect(ch chan<- prometheus.Metric) {
-	ctx, span := tracer.Start(c.ctx, "Collect")
-	defer span.End()
-
-	wg := &sync.WaitGroup{}
+	c.scraper.Collect(c.ctx, c.targets, ch)
+}
 
-	wg.Add(len(c.devices))
-	for _, d := range c.devices {
-		go c.collectForHost(ctx, d, ch, wg)
+// collectCached serves the results of a collector from the scrape cache if a cache TTL or a minimum interval is configured
+func (c *junosCollector) collectCached(t *scrape.Target, col *scrape.Collector, cl collector.Client, collect scrape.CollectFunc) scrape.CollectFunc {
+	ttl := cacheTTLForCollector(t.Device.Host, col.Key)
+	if ttl <= 0 {
+		return collect
 	}
 
-	wg.Wait()
+	key := resultCacheKey{target: t.Device.Host, logicalSystem: c.logicalSystem, routingInstance: c.routingInstance, collector: col.Name()}
+	return func(ch chan<- prometheus.Metric) error {
+		return scrapeCache.collectCached(key, ttl, cl.Context(), collect, ch)
+	}
 }
 
-func (c *junosCollector) collectForHost(ctx context.Context, device *connector.Device, ch chan<- prometheus.Metric, wg *sync.WaitGroup) {
-	defer wg.Done()
-
-	ctx, span := tracer.Start(ctx, "CollectForHost", trace.WithAttributes(
-		attribute.String("host", device.Host),
-	))
-	defer span.End()
-
-	l := []string{device.Host}
+// cacheTTLForCollector returns the duration the result of a collector is cached for a device (0 = not cached).
+// A minimum interval of the collector is served by the cache, the result is kept until the collector has to run again.
+func cacheTTLForCollector(host, collector string) time.Duration {
+	if interval := cfg.MinIntervalForDevice(host, collector); interval > 0 {
+		return interval
+	}
 
-	t := time.Now()
-	defer func() {
-		ch <- prometheus.MustNewConstMetric(scrapeDurationDesc, prometheus.GaugeValue, time.Since(t).Seconds(), l...)
-	}()
+	return cfg.CacheTTL
+}
 
-	cl, found := c.clients[device]
-	if !found {
-		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0, l...)
-		return
+// usesCache returns if the result of any collector of the target is served by the cache
+func usesCache(t *scrape.Target) bool {
+	for _, col := range t.Collectors {
+		if cacheTTLForCollector(t.Device.Host, col.Key) > 0 {
+			return true
+		}
 	}
 
-	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 1, l...)
-
-	for _, col := range c.collectors.collectorsForDevice(device) {
-		ctx, sp := tracer.Start(ctx, "CollectForHostWithCollector", trace.WithAttributes(
-			attribute.String("collector", col.Name()),
-		))
+	return false
+}
 
-		cta := &clientTracingAdapter{
-			cl:  cl,
-			ctx: ctx,
+// targetCollected exports the metrics of the exporter kept across scrapes for a target
+func (c *junosCollector) targetCollected(t *scrape.Target, up bool, ch chan<- prometheus.Metric, l []string) {
+	if t.Client != nil {
+		if usesCache(t) {
+			scrapeCache.collect(ch, l)
 		}
 
-		ct := time.Now()
-		err := col.Collect(cta, ch, l)
-
-		if err != nil && err.Error() != "EOF" {
-			sp.RecordError(err)
-			sp.SetStatus(codes.Error, err.Error())
-			log.Errorln(col.Name() + ": " + err.Error())
+		if stats, found := connManager.Stats(t.Device); found {
+			ch <- prometheus.MustNewConstMetric(sshConnectionUptimeDesc, prometheus.GaugeValue, time.Since(stats.ConnectedSince).Seconds(), l...)
+			ch <- prometheus.MustNewConstMetric(sshReconnectsDesc, prometheus.CounterValue, float64(stats.Reconnects), l...)
 		}
+	}
 
-		ch <- prometheus.MustNewConstMetric(scrapeCollectorDurationDesc, prometheus.GaugeValue, time.Since(ct).Seconds(), append(l, col.Name())...)
-		sp.End()
+	key := lastScrapeKey{target: t.Device.Host, logicalSystem: c.logicalSystem, routingInstance: c.routingInstance}
+	if up {
+		lastScrapes.succeeded(key, time.Now())
 	}
+	lastScrapes.collect(key, ch, l)
 }
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// hostKeyCallback verifies host keys against the known_hosts file. In lenient mode failed verifications are only logged.
+func hostKeyCallback(c *config.KnownHostsConfig) (ssh.HostKeyCallback, error) {
+	cb, err := knownhosts.New(c.File)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Mode != config.KnownHostsLenient {
+		return cb, nil
+	}
+
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if err := cb(hostname, remote, key); err != nil {
+			log.WithField("host", hostname).Warnf("Host key verification failed (ignored in lenient mode): %v", err)
+		}
+
+		return nil
+	}, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/ed25519"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestHostKeyCallback(t *testing.T) {
+	known := testPublicKey(t)
+	other := testPublicKey(t)
+
+	file := filepath.Join(t.TempDir(), "known_hosts")
+	line := knownhosts.Line([]string{knownhosts.Normalize("router1:22")}, known)
+	if err := os.WriteFile(file, []byte(line+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 22}
+
+	cb, err := hostKeyCallback(&config.KnownHostsConfig{File: file})
+	assert.NoError(t, err)
+	assert.NoError(t, cb("router1:22", addr, known), "known key")
+	assert.Error(t, cb("router1:22", addr, other), "mismatch in strict mode")
+	assert.Error(t, cb("router2:22", addr, known), "unknown host in strict mode")
+
+	cb, err = hostKeyCallback(&config.KnownHostsConfig{File: file, Mode: config.KnownHostsLenient})
+	assert.NoError(t, err)
+	assert.NoError(t, cb("router1:22", addr, other), "mismatch in lenient mode")
+
+	_, err = hostKeyCallback(&config.KnownHostsConfig{File: filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err, "missing file")
+}
+
+func testPublicKey(t *testing.T) ssh.PublicKey {
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	lastScrapeSuccessDesc *prometheus.Desc
+	lastScrapes           = newLastScrapeTimes()
+)
+
+func init() {
+	lastScrapeSuccessDesc = prometheus.NewDesc(prefix+"last_scrape_success_timestamp_seconds", "Unix timestamp of the last scrape of the target with junos_up = 1", []string{"target"}, nil)
+}
+
+type lastScrapeKey struct {
+	target          string
+	logicalSystem   string
+	routingInstance string
+}
+
+// lastScrapeTimes keeps the time of the last successful scrape per target across scrapes
+type lastScrapeTimes struct {
+	mu    sync.Mutex
+	times map[lastScrapeKey]time.Time
+}
+
+func newLastScrapeTimes() *lastScrapeTimes {
+	return &lastScrapeTimes{
+		times: make(map[lastScrapeKey]time.Time),
+	}
+}
+
+func (s *lastScrapeTimes) succeeded(key lastScrapeKey, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.times[key] = t
+}
+
+// collect exports the time of the last successful scrape (nothing if the target was never scraped successfully)
+func (s *lastScrapeTimes) collect(key lastScrapeKey, ch chan<- prometheus.Metric, l []string) {
+	s.mu.Lock()
+	t, found := s.times[key]
+	s.mu.Unlock()
+
+	if !found {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(lastScrapeSuccessDesc, prometheus.GaugeValue, float64(t.UnixNano())/1e9, l...)
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastScrapeTimes(t *testing.T) {
+	s := newLastScrapeTimes()
+	key := lastScrapeKey{target: "router1"}
+
+	ch := make(chan prometheus.Metric, 1)
+	s.collect(key, ch, []string{"router1"})
+	assert.Equal(t, 0, len(ch), "never scraped successfully")
+
+	s.succeeded(key, time.Unix(1700000000, 0))
+	s.collect(key, ch, []string{"router1"})
+	assert.Equal(t, 1, len(ch), "scraped successfully")
+
+	m := &dto.Metric{}
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, float64(1700000000), m.GetGauge().GetValue())
+
+	s.collect(lastScrapeKey{target: "router1", logicalSystem: "ls1"}, ch, []string{"router1"})
+	assert.Equal(t, 0, len(ch), "other logical system")
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// listenAddresses is a repeatable flag, values given on the command line replace the default value
+type listenAddresses struct {
+	values []string
+	set    bool
+}
+
+func listenAddressesFlag(name, defaultValue, usage string) *listenAddresses {
+	f := &listenAddresses{values: []string{defaultValue}}
+	flag.Var(f, name, usage)
+
+	return f
+}
+
+// String implements flag.Value interface
+func (f *listenAddresses) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(f.values, ",")
+}
+
+// Set implements flag.Value interface, an empty value adds no address
+func (f *listenAddresses) Set(value string) error {
+	if !f.set {
+		f.values = nil
+		f.set = true
+	}
+
+	if len(value) > 0 {
+		f.values = append(f.values, value)
+	}
+
+	return nil
+}
+
+// listeners opens a TCP listener for each address and the Unix domain socket listener (if socketPath is set).
+// If one of the listeners can not be opened, all listeners already opened are closed.
+func listeners(addresses []string, socketPath string) ([]net.Listener, error) {
+	addresses = nonEmpty(addresses)
+	if len(addresses) == 0 && len(socketPath) == 0 {
+		return nil, errors.New("neither listen address nor unix socket configured")
+	}
+
+	ls := make([]net.Listener, 0, len(addresses)+1)
+	for _, address := range addresses {
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			closeListeners(ls)
+			return nil, fmt.Errorf("could not listen on %s: %w", address, err)
+		}
+
+		ls = append(ls, l)
+	}
+
+	if len(socketPath) > 0 {
+		l, err := listenUnix(socketPath)
+		if err != nil {
+			closeListeners(ls)
+			return nil, err
+		}
+
+		ls = append(ls, l)
+	}
+
+	return ls, nil
+}
+
+// listenUnix listens on the socket, a stale socket left by a previous process is removed
+func listenUnix(path string) (net.Listener, error) {
+	if fi, err := os.Stat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("could not listen on %s: file exists and is not a socket", path)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("could not remove stale socket %s: %w", path, err)
+		}
+	}
+
+	return net.Listen("unix", path)
+}
+
+func nonEmpty(values []string) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if len(v) > 0 {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+func closeListeners(ls []net.Listener) {
+	for _, l := range ls {
+		l.Close()
+	}
+}
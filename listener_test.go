@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListeners(t *testing.T) {
+	_, err := listeners([]string{""}, "")
+	assert.Error(t, err, "nothing to listen on")
+
+	path := filepath.Join(t.TempDir(), "junos_exporter.sock")
+	ls, err := listeners([]string{"127.0.0.1:0"}, path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(ls), "tcp and unix socket")
+	assert.Equal(t, "tcp", ls[0].Addr().Network())
+	assert.Equal(t, "unix", ls[1].Addr().Network())
+
+	conn, err := net.Dial("unix", path)
+	assert.NoError(t, err, "socket accepts connections")
+	conn.Close()
+	closeListeners(ls)
+
+	ls, err = listeners(nil, path)
+	assert.NoError(t, err, "socket only")
+	assert.Equal(t, 1, len(ls))
+	closeListeners(ls)
+}
+
+func TestListenersNoSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(path, []byte("data"), 0600))
+
+	_, err := listeners(nil, path)
+	assert.ErrorContains(t, err, "is not a socket")
+}
+
+func TestListenersMultipleAddresses(t *testing.T) {
+	ls, err := listeners([]string{"127.0.0.1:0", "[::1]:0"}, "")
+	if err != nil {
+		t.Skipf("IPv6 loopback not available: %v", err)
+	}
+	assert.Equal(t, 2, len(ls), "one listener per address")
+	closeListeners(ls)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	_, err = listeners([]string{"127.0.0.1:0", l.Addr().String()}, "")
+	assert.ErrorContains(t, err, "could not listen on "+l.Addr().String())
+}
+
+func TestListenAddressesFlag(t *testing.T) {
+	f := &listenAddresses{values: []string{":9326"}}
+	assert.Equal(t, ":9326", f.String(), "default")
+
+	assert.NoError(t, f.Set("127.0.0.1:9326"))
+	assert.NoError(t, f.Set("[::1]:9326"))
+	assert.Equal(t, []string{"127.0.0.1:9326", "[::1]:9326"}, f.values, "default replaced")
+
+	f = &listenAddresses{values: []string{":9326"}}
+	assert.NoError(t, f.Set(""))
+	assert.Empty(t, f.values, "unix socket only")
+}
@@ -3,10 +3,11 @@
 package main
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,33 +17,48 @@ import (
 	"time"
 
 	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/features/stormcontrol"
+	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
+	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	"github.com/czerwonk/junos_exporter/pkg/telemetry"
 	"go.opentelemetry.io/otel/codes"
 
 	"github.com/czerwonk/junos_exporter/internal/config"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/proxy"
 )
 
 const version string = "0.12.2"
 
 var (
 	showVersion                 = flag.Bool("version", false, "Print version information.")
-	listenAddress               = flag.String("web.listen-address", ":9326", "Address on which to expose metrics and web interface.")
+	listenAddress               = listenAddressesFlag("web.listen-address", ":9326", "Address on which to expose metrics and web interface, can be repeated to listen on multiple addresses (empty to only listen on -web.listen-unix-socket).")
+	listenUnixSocket            = flag.String("web.listen-unix-socket", "", "Path of a Unix domain socket on which to expose metrics and web interface in addition to -web.listen-address.")
 	metricsPath                 = flag.String("web.telemetry-path", "/metrics", "Path under which to expose metrics.")
+	enableOpenMetrics           = flag.Bool("web.enable-openmetrics", false, "Expose metrics in OpenMetrics format if requested by the Accept header (counters without _total suffix get the suffix appended)")
 	sshHosts                    = flag.String("ssh.targets", "", "Hosts to scrape")
 	sshUsername                 = flag.String("ssh.user", "junos_exporter", "Username to use when connecting to junos devices using ssh")
 	sshKeyFile                  = flag.String("ssh.keyfile", "", "Public key file to use when connecting to junos devices using ssh")
 	sshKeyPassphrase            = flag.String("ssh.keyPassphrase", "", "Passphrase to decrypt key file if it's encrypted")
 	sshPassword                 = flag.String("ssh.password", "", "Password to use when connecting to junos devices using ssh")
 	sshReconnectInterval        = flag.Duration("ssh.reconnect-interval", 30*time.Second, "Duration to wait before reconnecting to a device after connection got lost")
-	sshKeepAliveInterval        = flag.Duration("ssh.keep-alive-interval", 10*time.Second, "Duration to wait between keep alive messages")
+	sshConnectBackoff           = flag.Duration("ssh.connect-backoff", 0, "Duration no connection to a device is attempted after a failed attempt, doubled after each further failure (0 = disabled)")
+	sshConnectBackoffMax        = flag.Duration("ssh.connect-backoff-max", 5*time.Minute, "Maximum duration no connection to a device is attempted after failed attempts (0 = no limit)")
+	sshConnectTimeout           = flag.Duration("ssh.connect-timeout", 5*time.Second, "Maximum duration to establish the SSH connection to a device (overridden by connect_timeout in the config file)")
+	sshKeepAliveInterval        = flag.Duration("ssh.keep-alive-interval", 10*time.Second, "Duration to wait between keep alive messages (overridden by keepalive_interval in the config file)")
 	sshKeepAliveTimeout         = flag.Duration("ssh.keep-alive-timeout", 15*time.Second, "Duration to wait for keep alive message response")
 	sshExpireTimeout            = flag.Duration("ssh.expire-timeout", 15*time.Minute, "Duration after an connection is terminated when it is not used")
+	rpcMaxRetries               = flag.Int("rpc.max-retries", 0, "Maximum number of retries for commands failing with transient errors (e.g. connection reset)")
+	rpcRetryBackoff             = flag.Duration("rpc.retry-backoff", 1*time.Second, "Duration to wait before the first retry (doubled on each further retry)")
+	sshMaxSessions              = flag.Int("ssh.max-sessions", 1, "Maximum number of concurrent sessions (commands) per device")
 	debug                       = flag.Bool("debug", false, "Show verbose debug output in log")
+	logFormat                   = flag.String("log-format", "text", "Format of log output (text or json)")
 	alarmEnabled                = flag.Bool("alarm.enabled", true, "Scrape Alarm metrics")
 	bgpEnabled                  = flag.Bool("bgp.enabled", true, "Scrape BGP metrics")
-	ospfEnabled                 = flag.Bool("ospf.enabled", true, "Scrape OSPFv3 metrics")
+	ospfEnabled                 = flag.Bool("ospf.enabled", true, "Scrape OSPFv2 metrics")
+	ospf3Enabled                = flag.Bool("ospf3.enabled", true, "Scrape OSPFv3 metrics")
 	isisEnabled                 = flag.Bool("isis.enabled", false, "Scrape ISIS metrics")
 	l2circuitEnabled            = flag.Bool("l2circuit.enabled", false, "Scrape l2circuit metrics")
 	natEnabled                  = flag.Bool("nat.enabled", false, "Scrape NAT metrics")
@@ -51,10 +67,12 @@ var (
 	routingEngineEnabled        = flag.Bool("routingengine.enabled", true, "Scrape Routing Engine metrics")
 	routesEnabled               = flag.Bool("routes.enabled", true, "Scrape routing table metrics")
 	environmentEnabled          = flag.Bool("environment.enabled", true, "Scrape environment metrics")
-	firewallEnabled             = flag.Bool("firewall.enabled", true, "Scrape Firewall count metrics")
+	firewallEnabled             = flag.Bool("firewall.enabled", false, "Scrape firewall filter counter and policer metrics (output can be large on devices with many filters)")
 	interfacesEnabled           = flag.Bool("interfaces.enabled", true, "Scrape interface metrics")
+	interfaceRates              = flag.Bool("interfaces.rates", false, "Scrape input/output rates (bps/pps) as calculated by the device")
 	interfaceDiagnosticsEnabled = flag.Bool("ifdiag.enabled", true, "Scrape optical interface diagnostic metrics")
 	ipsecEnabled                = flag.Bool("ipsec.enabled", false, "Scrape IPSec metrics")
+	ipsecTunnelStatistics       = flag.Bool("ipsec.tunnel-statistics", false, "Scrape traffic statistics per IPSec tunnel (one command per tunnel)")
 	securityEnabled             = flag.Bool("security.enabled", false, "Scrape security metrics")
 	securityPoliciesEnabled     = flag.Bool("security_policies.enabled", false, "Scrape security policy metrics")
 	storageEnabled              = flag.Bool("storage.enabled", true, "Scrape system storage metrics")
@@ -65,12 +83,20 @@ var (
 	satelliteEnabled            = flag.Bool("satellite.enabled", false, "Scrape metrics from satellite devices")
 	systemEnabled               = flag.Bool("system.enabled", false, "Scrape system metrics")
 	macEnabled                  = flag.Bool("mac.enabled", false, "Scrape MAC address table metrics")
+	macVLANCounts               = flag.Bool("mac.vlan-counts", false, "Count MAC address table entries per VLAN (retrieves the complete table)")
 	alarmFilter                 = flag.String("alarms.filter", "", "Regex to filter for alerts to ignore")
+	multicastRouteLimit         = flag.Int("multicast.route-limit", 100, "Maximum number of multicast routes per instance exported with group and source labels (0 = disabled)")
 	configFile                  = flag.String("config.file", "", "Path to config file")
+	shutdownGracePeriod         = flag.Duration("web.shutdown-grace-period", 10*time.Second, "Maximum duration to wait for in-flight scrapes on shutdown before closing the connections to the devices")
+	metricsPrefix               = flag.String("metrics.prefix", "junos_", "Prefix of the exported metrics (replacing junos_)")
+	dryRunTarget                = flag.String("dry-run", "", "Print the commands a scrape of the target would run without connecting to the device and exit ('all' for all configured targets)")
+	checkConfig                 = flag.Bool("config.check", false, "Validate the config file and exit (non-zero exit code on errors)")
 	dynamicIfaceLabels          = flag.Bool("dynamic-interface-labels", true, "Parse interface descriptions to get labels dynamically")
+	dynamicIfaceLabelsRefresh   = flag.Duration("dynamic-interface-labels.refresh-interval", 0, "Duration interface descriptions are cached per target before they are retrieved again (0 = retrieved on every scrape)")
 	interfaceDescriptionRegex   = flag.String("interface-description-regex", "", "give a regex to retrieve the interface description labels")
 	lsEnabled                   = flag.Bool("logical-systems.enabled", false, "Enable logical systems support")
 	powerEnabled                = flag.Bool("power.enabled", true, "Scrape power metrics")
+	powerFRU                    = flag.Bool("power.fru-power", false, "Scrape power consumption per FPC and PIC (show chassis power detail)")
 	lacpEnabled                 = flag.Bool("lacp.enabled", false, "Scrape LACP metrics")
 	bfdEnabled                  = flag.Bool("bfd.enabled", false, "Scrape BFD metrics")
 	vpwsEnabled                 = flag.Bool("vpws.enabled", false, "Scrape EVPN VPWS metrics")
@@ -79,13 +105,46 @@ var (
 	tlsEnabled                  = flag.Bool("tls.enabled", false, "Enables TLS")
 	tlsCertChainPath            = flag.String("tls.cert-file", "", "Path to TLS cert file")
 	tlsKeyPath                  = flag.String("tls.key-file", "", "Path to TLS key file")
+	basicAuthUsername           = flag.String("web.basic-auth.username", "", "Username required to access the web interface (basic auth is disabled if empty)")
+	basicAuthPasswordFile       = flag.String("web.basic-auth.password-file", "", "Path to file containing the password required to access the web interface")
 	tracingEnabled              = flag.Bool("tracing.enabled", false, "Enables tracing using OpenTelemetry")
 	tracingProvider             = flag.String("tracing.provider", "", "Sets the tracing provider (stdout or collector)")
 	tracingCollectorEndpoint    = flag.String("tracing.collector.grpc-endpoint", "", "Sets the tracing provider (stdout or collector)")
+	otlpMetricsEndpoint         = flag.String("otlp.metrics.grpc-endpoint", "", "Exports metrics of the exporter itself (scrape and collector durations, errors, RPC commands) via OTLP to the gRPC endpoint (disabled if empty)")
+	otlpMetricsInterval         = flag.Duration("otlp.metrics.interval", time.Minute, "Interval the metrics are exported via OTLP")
 	subscriberEnabled           = flag.Bool("subscriber.enabled", false, "Scrape subscribers detail")
+	stormControlEnabled         = flag.Bool("storm_control.enabled", false, "Scrape storm control metrics")
+	gnmiEnabled                 = flag.Bool("gnmi.enabled", false, "Export interface counters streamed via gNMI (experimental, requires the gnmi section of the config file)")
+	ddosProtectionEnabled       = flag.Bool("ddos_protection.enabled", false, "Scrape DDoS protection (jddosd) metrics")
+	versionEnabled              = flag.Bool("version.enabled", false, "Scrape software version information")
+	rsvpEnabled                 = flag.Bool("rsvp.enabled", false, "Scrape RSVP interface and neighbor metrics")
+	l2vpnEnabled                = flag.Bool("l2vpn.enabled", false, "Scrape L2VPN and VPLS connection metrics")
+	multicastEnabled            = flag.Bool("multicast.enabled", false, "Scrape PIM neighbor and multicast route metrics")
+	dhcpEnabled                 = flag.Bool("dhcp.enabled", false, "Scrape DHCP relay statistics and DHCP server binding metrics")
+	ntpEnabled                  = flag.Bool("ntp.enabled", false, "Scrape NTP synchronization status metrics")
+	evpnEnabled                 = flag.Bool("evpn.enabled", false, "Scrape EVPN instance and MAC database metrics")
+	bgpDampingEnabled           = flag.Bool("bgp_damping.enabled", false, "Scrape BGP route flap damping metrics")
+	commitEnabled               = flag.Bool("commit.enabled", false, "Scrape the timestamp of the last configuration commit")
+	arpEnabled                  = flag.Bool("arp.enabled", false, "Scrape ARP and IPv6 neighbor table sizes")
+	poeEnabled                  = flag.Bool("poe.enabled", false, "Scrape PoE interface and controller power metrics")
+	fabricEnabled               = flag.Bool("fabric.enabled", false, "Scrape switch fabric plane metrics")
+	cosEnabled                  = flag.Bool("cos.enabled", false, "Scrape class of service bindings and shaping rates of interfaces")
+	flowMonitoringEnabled       = flag.Bool("flow_monitoring.enabled", false, "Scrape inline flow monitoring (jflow/IPFIX) metrics")
+	usersEnabled                = flag.Bool("users.enabled", false, "Scrape number of logged in users and login sessions")
+	usersLoginClasses           = flag.Bool("users.login-classes", false, "Resolve the login class of logged in users from the configuration (show configuration system login, the exporter user has to be allowed to view it)")
+	scrapeTimeout               = flag.Duration("scrape.timeout", 0, "Maximum duration of a scrape for a single target (0 = no timeout)")
+	cacheTTL                    = flag.Duration("cache.ttl", 0, "Duration collector results are cached per target (0 = caching disabled)")
+	scrapeMaxConcurrentTargets  = flag.Int("scrape.max-concurrent-targets", 0, "Maximum number of targets scraped concurrently per scrape (0 = unlimited)")
+	scrapeMaxSeries             = flag.Int("scrape.max-series-per-collector", 0, "Maximum number of series a collector may emit per target and scrape, further series are dropped (0 = unlimited)")
+	auditLogFile                = flag.String("audit.log-file", "", "Path to file every command sent to a device is appended to (one JSON object per line, disabled if empty)")
 	cfg                         *config.Config
 	devices                     []*connector.Device
 	connManager                 *connector.SSHConnectionManager
+	auditLog                    *rpc.AuditLog
+	descriptionCache            *interfacelabels.DescriptionCache
+	stormControlTriggers        = stormcontrol.NewTriggers()
+	telemetryCache              = telemetry.NewCache()
+	telemetryManager            *telemetry.Manager
 	reloadCh                    chan chan error
 	configMu                    sync.RWMutex
 )
@@ -106,32 +165,100 @@ func main() {
 		os.Exit(0)
 	}
 
+	if err := initLogging(); err != nil {
+		log.Fatal(err)
+	}
+
+	if !metricsPrefixRegex.MatchString(*metricsPrefix) {
+		log.Fatalf("invalid metrics prefix: %s", *metricsPrefix)
+	}
+
+	if *checkConfig {
+		if err := validateConfig(); err != nil {
+			fmt.Fprintf(os.Stderr, "config is invalid:\n%v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("config is valid")
+		os.Exit(0)
+	}
+
 	err := initialize()
 	if err != nil {
 		log.Fatalf("could not initialize exporter. %v", err)
 	}
 
-	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
-	defer cancel()
+	if len(*dryRunTarget) > 0 {
+		if err := dryRun(os.Stdout, *dryRunTarget); err != nil {
+			log.Fatal(err)
+		}
+
+		os.Exit(0)
+	}
+
+	if err := startTelemetry(); err != nil {
+		log.Fatalf("could not initialize gNMI subscriptions: %v", err)
+	}
 
-	shutdownTracing, err := initTracing(ctx)
+	if *dynamicIfaceLabelsRefresh > 0 {
+		descriptionCache = interfacelabels.NewDescriptionCache(*dynamicIfaceLabelsRefresh)
+	}
+
+	if err := initAuditLog(); err != nil {
+		log.Fatalf("could not initialize audit log: %v", err)
+	}
+
+	shutdownTracing, err := initTracing(context.Background())
 	if err != nil {
 		log.Fatalf("could not initialize tracing: %v", err)
 	}
 	defer shutdownTracing()
 
+	shutdownMetrics, err := initOTLPMetrics(context.Background())
+	if err != nil {
+		log.Fatalf("could not initialize OTLP metrics export: %v", err)
+	}
+	defer shutdownMetrics()
+
 	initChannels()
 
-	startServer()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	startServer(ctx)
+}
+
+func initLogging() error {
+	switch *logFormat {
+	case "text":
+		log.SetFormatter(&log.TextFormatter{})
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	default:
+		return fmt.Errorf("invalid log format: %s (expected text or json)", *logFormat)
+	}
+
+	return nil
+}
+
+func initAuditLog() error {
+	if len(*auditLogFile) == 0 {
+		return nil
+	}
+
+	f, err := os.OpenFile(*auditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	auditLog = rpc.NewAuditLog(f)
+	return nil
 }
 
 func initChannels() {
 	hup := make(chan os.Signal, 1)
 	signal.Notify(hup, syscall.SIGHUP)
 
-	term := make(chan os.Signal, 1)
-	signal.Notify(term, syscall.SIGTERM)
-
 	reloadCh = make(chan chan error)
 	go func() {
 		for {
@@ -149,10 +276,6 @@ func initChannels() {
 				} else {
 					rc <- nil
 				}
-			case <-term:
-				log.Infoln("Closing connections to devices")
-				connManager.Close()
-				os.Exit(0)
 			}
 		}
 	}()
@@ -171,41 +294,128 @@ func initialize() error {
 		return err
 	}
 
+	err = validate(c)
+	if err != nil {
+		return err
+	}
+
 	devices, err = devicesForConfig(c)
 	if err != nil {
 		return err
 	}
-	cfg = c
 
-	connManager = connectionManager()
+	err = validateStaticLabels(c, devices)
+	if err != nil {
+		return err
+	}
+	cfg = c
 
-	return nil
+	connManager, err = connectionManager(c)
+	return err
 }
 
 func reinitialize() error {
 	configMu.Lock()
 	defer configMu.Unlock()
 
-	if connManager != nil {
+	if connManager == nil {
+		return initialize()
+	}
+
+	resetKeyAuthCache()
+
+	c, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	err = validate(c)
+	if err != nil {
+		return err
+	}
+
+	devs, err := devicesForConfig(c)
+	if err != nil {
+		return err
+	}
+
+	err = validateStaticLabels(c, devs)
+	if err != nil {
+		return err
+	}
+
+	targets, err := telemetryTargets(c, devs)
+	if err != nil {
+		return err
+	}
+
+	if sameConnectionManagerSettings(cfg, c) {
+		closeConnectionsForChangedDevices(connManager, cfg, c, devs)
+	} else {
+		m, err := connectionManager(c)
+		if err != nil {
+			return err
+		}
+
+		log.Infoln("Closing all connections since the connection settings changed")
 		connManager.Close()
-		connManager = nil
+		connManager = m
 	}
 
-	return initialize()
+	scrapeCache.reset()
+	if descriptionCache != nil {
+		descriptionCache.Reset()
+	}
+	stormControlTriggers.Prune(func(host string) bool {
+		return deviceForHost(devs, host) != nil || c.FindDeviceConfig(host) != nil
+	})
+	if telemetryManager != nil {
+		telemetryManager.Start(targets)
+	}
+
+	devices = devs
+	cfg = c
+
+	return nil
 }
 
 func loadConfig() (*config.Config, error) {
+	return loadConfigWithLoader(config.LoadFile)
+}
+
+func loadConfigWithLoader(load func(string) (*config.Config, error)) (*config.Config, error) {
 	if len(*configFile) == 0 {
 		return loadConfigFromFlags(), nil
 	}
 
 	log.Infoln("Loading config from", *configFile)
-	b, err := os.ReadFile(*configFile)
+	return load(*configFile)
+}
+
+// validateConfig checks the config using the same code path as used on startup, but fails on unknown keys
+func validateConfig() error {
+	c, err := loadConfigWithLoader(config.LoadFileStrict)
+	if err != nil {
+		return err
+	}
+
+	err = validate(c)
+	if err != nil {
+		return err
+	}
+
+	devs, err := devicesForConfig(c)
+	if err != nil {
+		return err
+	}
+
+	err = validateStaticLabels(c, devs)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return config.Load(bytes.NewReader(b))
+	_, err = telemetryTargets(c, devs)
+	return err
 }
 
 func loadConfigFromFlags() *config.Config {
@@ -213,6 +423,10 @@ func loadConfigFromFlags() *config.Config {
 	c.Targets = strings.Split(*sshHosts, ",")
 	c.LSEnabled = *lsEnabled
 	c.IfDescReg = *interfaceDescriptionRegex
+	c.ScrapeTimeout = *scrapeTimeout
+	c.MaxConcurrentTargets = *scrapeMaxConcurrentTargets
+	c.MaxCollectorSeries = *scrapeMaxSeries
+	c.CacheTTL = *cacheTTL
 
 	f := &c.Features
 	f.Alarm = *alarmEnabled
@@ -229,6 +443,7 @@ func loadConfigFromFlags() *config.Config {
 	f.NAT = *natEnabled
 	f.NAT2 = *nat2Enabled
 	f.OSPF = *ospfEnabled
+	f.OSPF3 = *ospf3Enabled
 	f.LDP = *ldpEnabled
 	f.L2Circuit = *l2circuitEnabled
 	f.Routes = *routesEnabled
@@ -247,21 +462,83 @@ func loadConfigFromFlags() *config.Config {
 	f.MPLSLSP = *mplsLSPEnabled
 	f.License = *licenseEnabled
 	f.Subscriber = *subscriberEnabled
+	f.Users = *usersEnabled
+	f.FlowMonitoring = *flowMonitoringEnabled
+	f.CoS = *cosEnabled
+	f.Fabric = *fabricEnabled
+	f.PoE = *poeEnabled
+	f.ARP = *arpEnabled
+	f.Commit = *commitEnabled
+	f.BGPDamping = *bgpDampingEnabled
+	f.EVPN = *evpnEnabled
+	f.NTP = *ntpEnabled
+	f.DHCP = *dhcpEnabled
+	f.Multicast = *multicastEnabled
+	f.L2VPN = *l2vpnEnabled
+	f.RSVP = *rsvpEnabled
+	f.Version = *versionEnabled
+	f.DDoSProtection = *ddosProtectionEnabled
+	f.StormControl = *stormControlEnabled
+	f.GNMI = *gnmiEnabled
 	return c
 }
 
-func connectionManager() *connector.SSHConnectionManager {
+func connectionManager(c *config.Config) (*connector.SSHConnectionManager, error) {
+	connectTimeout := *sshConnectTimeout
+	if c.ConnectTimeout > 0 {
+		connectTimeout = c.ConnectTimeout
+	}
+
+	keepAliveInterval := *sshKeepAliveInterval
+	if c.KeepAliveInterval > 0 {
+		keepAliveInterval = c.KeepAliveInterval
+	}
+
 	opts := []connector.Option{
 		connector.WithReconnectInterval(*sshReconnectInterval),
-		connector.WithKeepAliveInterval(*sshKeepAliveInterval),
+		connector.WithConnectTimeout(connectTimeout),
+		connector.WithKeepAliveInterval(keepAliveInterval),
 		connector.WithKeepAliveTimeout(*sshKeepAliveTimeout),
 		connector.WithExpiredConnectionTimeout(*sshExpireTimeout),
+		connector.WithMaxSessionsPerDevice(*sshMaxSessions),
+		connector.WithConnectBackoff(*sshConnectBackoff, *sshConnectBackoffMax),
+	}
+
+	if c.SOCKS5Proxy != nil {
+		d, err := socks5Dialer(c.SOCKS5Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("could not initialize SOCKS5 proxy: %w", err)
+		}
+
+		opts = append(opts, connector.WithProxyDialer(d))
 	}
 
-	return connector.NewConnectionManager(opts...)
+	if c.KnownHosts != nil {
+		cb, err := hostKeyCallback(c.KnownHosts)
+		if err != nil {
+			return nil, fmt.Errorf("could not load known_hosts file: %w", err)
+		}
+
+		opts = append(opts, connector.WithHostKeyCallback(cb))
+	}
+
+	return connector.NewConnectionManager(opts...), nil
+}
+
+func socks5Dialer(c *config.SOCKS5ProxyConfig) (proxy.Dialer, error) {
+	var auth *proxy.Auth
+	if len(c.Username) > 0 {
+		auth = &proxy.Auth{
+			User:     c.Username,
+			Password: c.Password,
+		}
+	}
+
+	return proxy.SOCKS5("tcp", c.Address, auth, proxy.Direct)
 }
 
-func startServer() {
+// startServer serves HTTP requests until ctx is done, then shuts down gracefully
+func startServer(ctx context.Context) {
 	log.Infof("Starting JunOS exporter (Version: %s)", version)
 	http.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
 		w.Write([]byte(`<html>
@@ -276,14 +553,87 @@ func startServer() {
 	})
 	http.HandleFunc(*metricsPath, handleMetricsRequest)
 	http.HandleFunc("/-/reload", updateConfiguration)
+	http.HandleFunc("/-/healthy", handleHealthyRequest)
+	http.HandleFunc("/-/ready", handleReadyRequest)
+
+	var handler http.Handler = http.DefaultServeMux
+	if len(*basicAuthUsername) > 0 {
+		password, err := loadBasicAuthPassword(*basicAuthPasswordFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		handler = withBasicAuth(handler, *basicAuthUsername, password)
+	}
 
-	log.Infof("Listening for %s on %s (TLS: %v)", *metricsPath, *listenAddress, *tlsEnabled)
-	if *tlsEnabled {
-		log.Fatal(http.ListenAndServeTLS(*listenAddress, *tlsCertChainPath, *tlsKeyPath, nil))
+	srv := &http.Server{
+		Handler: handler,
+	}
+
+	ls, err := listeners(listenAddress.values, *listenUnixSocket)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, l := range ls {
+		log.Infof("Listening for %s on %s (TLS: %v, basic auth: %v)", *metricsPath, l.Addr(), *tlsEnabled, len(*basicAuthUsername) > 0)
+		go func(l net.Listener) {
+			var err error
+			if *tlsEnabled {
+				err = srv.ServeTLS(l, *tlsCertChainPath, *tlsKeyPath)
+			} else {
+				err = srv.Serve(l)
+			}
+
+			if err != nil && err != http.ErrServerClosed {
+				log.Fatal(err)
+			}
+		}(l)
+	}
+
+	<-ctx.Done()
+	shutdown(srv)
+}
+
+// shutdown stops accepting requests, waits for in-flight scrapes (at most the grace period) and closes the connections to the devices
+func shutdown(srv *http.Server) {
+	log.Infof("Shutting down (grace period: %v)", *shutdownGracePeriod)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownGracePeriod)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Errorf("could not shut down HTTP server gracefully: %v", err)
+	}
+
+	if telemetryManager != nil {
+		telemetryManager.Stop()
+	}
+
+	log.Infoln("Closing connections to devices")
+	connManager.Close()
+}
+
+// handleHealthyRequest reports that the process is alive
+func handleHealthyRequest(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+// handleReadyRequest reports if the config is loaded and metrics requests can be served.
+// It does neither open connections to devices nor run any collectors.
+func handleReadyRequest(w http.ResponseWriter, _ *http.Request) {
+	configMu.RLock()
+	ready := cfg != nil
+	configMu.RUnlock()
+
+	if !ready {
+		http.Error(w, "config not loaded", http.StatusServiceUnavailable)
 		return
 	}
 
-	log.Fatal(http.ListenAndServe(*listenAddress, nil))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
 }
 
 func updateConfiguration(w http.ResponseWriter, r *http.Request) {
@@ -304,7 +654,7 @@ func handleMetricsRequest(w http.ResponseWriter, r *http.Request) {
 	configMu.RLock()
 	defer configMu.RUnlock()
 
-	ctx, span := tracer.Start(r.Context(), "HandleMetricsRequest")
+	ctx, span := tracer.Start(contextFromRequest(r), "HandleMetricsRequest")
 	defer span.End()
 
 	reg := prometheus.NewRegistry()
@@ -317,33 +667,129 @@ func handleMetricsRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	logicalSystem := r.URL.Query().Get("ls")
-	if !cfg.LSEnabled && logicalSystem != "" {
+	scrapes, err := logicalSystemScrapesForRequest(r, devs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	collectorFilter, err := collectorFilterForRequest(r)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		http.Error(w, fmt.Sprintf("Logical systems not enabled but the logical system '%s' in parameters", logicalSystem), 400)
+		http.Error(w, err.Error(), 400)
 		return
 	}
 
-	c := newJunosCollector(ctx, devs, logicalSystem)
-	reg.MustRegister(c)
+	labelLogicalSystem := false
+	labelRoutingInstance := false
+	for _, s := range scrapes {
+		labelLogicalSystem = labelLogicalSystem || s.logicalSystem != ""
+		labelRoutingInstance = labelRoutingInstance || s.routingInstance != ""
+	}
+
+	for _, s := range scrapes {
+		c := newJunosCollector(ctx, s.devices, s.logicalSystem, s.routingInstance, collectorFilter)
+
+		labels := prometheus.Labels{}
+		if labelLogicalSystem {
+			labels["logical_system"] = s.logicalSystem
+		}
+		if labelRoutingInstance {
+			labels["routing_instance"] = s.routingInstance
+		}
+
+		err = prometheus.WrapRegistererWith(labels, reg).Register(c)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			http.Error(w, err.Error(), 500)
+			return
+		}
+	}
+	if r.URL.Query().Get("target") == "" {
+		reg.MustRegister(&fleetCollector{devices: devices, connManager: connManager})
+	}
 
 	l := log.New()
 	l.Level = log.ErrorLevel
 
-	promhttp.HandlerFor(reg, promhttp.HandlerOpts{
-		ErrorLog:      l,
-		ErrorHandling: promhttp.ContinueOnError}).ServeHTTP(w, r)
+	promhttp.HandlerFor(withMetricsPrefix(reg, *metricsPrefix), promhttp.HandlerOpts{
+		ErrorLog:          l,
+		ErrorHandling:     promhttp.ContinueOnError,
+		EnableOpenMetrics: *enableOpenMetrics}).ServeHTTP(w, r)
+}
+
+type logicalSystemScrape struct {
+	logicalSystem   string
+	routingInstance string
+	devices         []*connector.Device
+}
+
+// logicalSystemScrapesForRequest groups the devices by the logical systems and routing instances to scrape.
+// The default logical system is always scraped unless a logical system is requested explicitly.
+// Routing instances are only scraped in the default logical system.
+func logicalSystemScrapesForRequest(r *http.Request, devs []*connector.Device) ([]*logicalSystemScrape, error) {
+	logicalSystem := r.URL.Query().Get("ls")
+	if !cfg.LSEnabled && logicalSystem != "" {
+		return nil, fmt.Errorf("Logical systems not enabled but the logical system '%s' in parameters", logicalSystem)
+	}
+
+	if logicalSystem != "" {
+		return []*logicalSystemScrape{{logicalSystem: logicalSystem, devices: devs}}, nil
+	}
+
+	scrapes := []*logicalSystemScrape{{devices: devs}}
+	lsScrapes := make(map[string]*logicalSystemScrape)
+	riScrapes := make(map[string]*logicalSystemScrape)
+	for _, d := range devs {
+		dc := cfg.FindDeviceConfig(d.Host)
+		if dc == nil {
+			continue
+		}
+
+		if cfg.LSEnabled {
+			for _, ls := range dc.LogicalSystems {
+				s, found := lsScrapes[ls]
+				if !found {
+					s = &logicalSystemScrape{logicalSystem: ls}
+					lsScrapes[ls] = s
+					scrapes = append(scrapes, s)
+				}
+
+				s.devices = append(s.devices, d)
+			}
+		}
+
+		for _, ri := range dc.RoutingInstances {
+			s, found := riScrapes[ri]
+			if !found {
+				s = &logicalSystemScrape{routingInstance: ri}
+				riScrapes[ri] = s
+				scrapes = append(scrapes, s)
+			}
+
+			s.devices = append(s.devices, d)
+		}
+	}
+
+	return scrapes, nil
 }
 
 func devicesForRequest(r *http.Request) ([]*connector.Device, error) {
-	reqTarget := r.URL.Query().Get("target")
+	return devicesForTarget(r.URL.Query().Get("target"))
+}
+
+// devicesForTarget returns the device matching the target (all devices if target is empty)
+func devicesForTarget(reqTarget string) ([]*connector.Device, error) {
 	if reqTarget == "" {
 		return devices, nil
 	}
 
 	for _, d := range devices {
-		if d.Host == reqTarget {
+		if config.SameHost(d.Host, reqTarget) {
 			return []*connector.Device{d}, nil
 		}
 	}
@@ -365,3 +811,120 @@ func devicesForRequest(r *http.Request) ([]*connector.Device, error) {
 
 	return nil, fmt.Errorf("the target '%s' is not defined in the configuration file", reqTarget)
 }
+
+// validate checks the values of a loaded config and the collector names referenced by it
+func validate(c *config.Config) error {
+	err := c.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = validateCollectorSets(c)
+	if err != nil {
+		return err
+	}
+
+	err = validateRPCOverrides(c)
+	if err != nil {
+		return err
+	}
+
+	return validateCollectorTimeouts(c)
+}
+
+// validateCollectorSets checks that the collectors allowed/denied per device are known
+func validateCollectorSets(c *config.Config) error {
+	known := collectorNames()
+
+	var errs []error
+	for _, d := range c.Devices {
+		if d.Collectors == nil {
+			continue
+		}
+
+		for _, name := range append(d.Collectors.Include, d.Collectors.Exclude...) {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("device %s: unknown collector '%s'", d.Host, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateRPCOverrides checks that the collectors commands are overridden for are known
+func validateRPCOverrides(c *config.Config) error {
+	known := collectorNames()
+
+	var errs []error
+	for name := range c.RPCOverrides {
+		if !known[name] {
+			errs = append(errs, fmt.Errorf("rpc_overrides: unknown collector '%s'", name))
+		}
+	}
+
+	for _, d := range c.Devices {
+		for name := range d.RPCOverrides {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("device %s: rpc_overrides: unknown collector '%s'", d.Host, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateCollectorTimeouts checks that the collectors timeouts and minimum intervals are configured for are known
+func validateCollectorTimeouts(c *config.Config) error {
+	known := collectorNames()
+
+	var errs []error
+	for name := range c.CollectorTimeouts {
+		if !known[name] {
+			errs = append(errs, fmt.Errorf("collector_timeouts: unknown collector '%s'", name))
+		}
+	}
+
+	for name := range c.MinIntervals {
+		if !known[name] {
+			errs = append(errs, fmt.Errorf("collector_min_intervals: unknown collector '%s'", name))
+		}
+	}
+
+	for _, d := range c.Devices {
+		for name := range d.CollectorTimeouts {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("device %s: collector_timeouts: unknown collector '%s'", d.Host, name))
+			}
+		}
+
+		for name := range d.MinIntervals {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("device %s: collector_min_intervals: unknown collector '%s'", d.Host, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// collectorFilterForRequest returns the set of collectors requested by the collectors parameter (nil = all configured collectors)
+func collectorFilterForRequest(r *http.Request) (map[string]bool, error) {
+	param := r.URL.Query().Get("collectors")
+	if param == "" {
+		return nil, nil
+	}
+
+	known := collectorNames()
+	filter := make(map[string]bool)
+	for _, name := range strings.Split(param, ",") {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, fmt.Errorf("unknown collector '%s'", name)
+		}
+
+		filter[name] = true
+	}
+
+	return filter, nil
+}
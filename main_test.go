@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+)
+
+func TestHandleReadyRequest(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+
+	cfg = nil
+	rec := httptest.NewRecorder()
+	handleReadyRequest(rec, httptest.NewRequest(http.MethodGet, "/-/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "config not loaded")
+
+	cfg = config.New()
+	rec = httptest.NewRecorder()
+	handleReadyRequest(rec, httptest.NewRequest(http.MethodGet, "/-/ready", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "config loaded")
+}
+
+func TestHandleHealthyRequest(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handleHealthyRequest(rec, httptest.NewRequest(http.MethodGet, "/-/healthy", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestInitializeRejectsInvalidInterfaceFilter(t *testing.T) {
+	oldCfg, oldDevices, oldConnManager, oldConfigFile := cfg, devices, connManager, *configFile
+	defer func() { cfg, devices, connManager, *configFile = oldCfg, oldDevices, oldConnManager, oldConfigFile }()
+
+	setConfigFile(t, "password: secret\ndevices:\n  - host: router1\ninterface_filter:\n  include:\n    - \"ge-(\"\n")
+	cfg, connManager = nil, nil
+	assert.Error(t, initialize())
+	assert.Nil(t, cfg, "config must not be applied")
+
+	setConfigFile(t, "password: secret\ndevices:\n  - host: router1\n")
+	assert.NoError(t, initialize())
+	defer connManager.Close()
+
+	setConfigFile(t, "password: secret\ndevices:\n  - host: router1\n    interface_filter:\n      exclude:\n        - \"[\"\n")
+	assert.Error(t, reinitialize())
+	assert.Nil(t, cfg.FindDeviceConfig("router1").IfFilter, "previous config has to be kept")
+}
+
+func setConfigFile(t *testing.T, content string) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	*configFile = path
+}
+
+func TestReinitializeKeepsConfigOnInvalidConfig(t *testing.T) {
+	oldCfg, oldDevices, oldConnManager, oldConfigFile := cfg, devices, connManager, *configFile
+	defer func() { cfg, devices, connManager, *configFile = oldCfg, oldDevices, oldConnManager, oldConfigFile }()
+
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{name: "invalid description regex", content: "interface_description_regex: \"[\"\n", err: "invalid interface_description_regex"},
+		{name: "invalid transport", content: "transport: telnet\n", err: "invalid transport"},
+		{name: "unknown collector in set", content: "devices:\n  - host: router1\n    collectors:\n      include: [bgp, unknown]\n", err: "unknown collector 'unknown'"},
+		{name: "unknown rpc override", content: "rpc_overrides:\n  unknown:\n    show version: show version brief\n", err: "rpc_overrides: unknown collector 'unknown'"},
+		{name: "unknown collector timeout", content: "collector_timeouts:\n  unknown: 10s\n", err: "collector_timeouts: unknown collector 'unknown'"},
+		{name: "unknown min interval", content: "devices:\n  - host: router1\n    collector_min_intervals:\n      unknown: 5m\n", err: "collector_min_intervals: unknown collector 'unknown'"},
+	}
+
+	setConfigFile(t, "password: secret\ndevices:\n  - host: router1\n")
+	cfg, connManager = nil, nil
+	assert.NoError(t, initialize())
+	defer connManager.Close()
+	valid := cfg
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content := test.content
+			if !strings.Contains(content, "devices:") {
+				content += "devices:\n  - host: router1\n"
+			}
+			setConfigFile(t, "password: secret\n"+content)
+
+			assert.ErrorContains(t, reinitialize(), test.err)
+			assert.Same(t, valid, cfg, "previous config has to be kept")
+		})
+	}
+
+	setConfigFile(t, "password: secret\ndevices:\n  - host: router2\n")
+	assert.NoError(t, reinitialize())
+	assert.NotNil(t, cfg.FindDeviceConfig("router2"), "valid config has to be applied")
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+var metricsPrefixRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// prefixedGatherer replaces the prefix of all metrics exported by the collectors (junos_) by a custom one
+type prefixedGatherer struct {
+	gatherer prometheus.Gatherer
+	prefix   string
+}
+
+func withMetricsPrefix(g prometheus.Gatherer, prefix string) prometheus.Gatherer {
+	if prefix == "junos_" {
+		return g
+	}
+
+	return &prefixedGatherer{gatherer: g, prefix: prefix}
+}
+
+// Gather implements prometheus.Gatherer interface
+func (g *prefixedGatherer) Gather() ([]*dto.MetricFamily, error) {
+	mfs, err := g.gatherer.Gather()
+
+	for _, mf := range mfs {
+		if !strings.HasPrefix(mf.GetName(), "junos_") {
+			continue
+		}
+
+		name := g.prefix + strings.TrimPrefix(mf.GetName(), "junos_")
+		mf.Name = &name
+	}
+
+	return mfs, err
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMetricsPrefix(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "junos_up", Help: "up"}))
+	reg.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "other_metric", Help: "other"}))
+
+	assert.Equal(t, reg, withMetricsPrefix(reg, "junos_"), "default prefix")
+
+	mfs, err := withMetricsPrefix(reg, "net_junos_").Gather()
+	assert.NoError(t, err)
+
+	names := make([]string, 0, len(mfs))
+	for _, mf := range mfs {
+		names = append(names, mf.GetName())
+	}
+
+	assert.ElementsMatch(t, []string{"net_junos_up", "other_metric"}, names)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric/global"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// initOTLPMetrics exports the metrics recorded using the global meter provider (e.g. by pkg/scrape) to the configured OTLP endpoint
+func initOTLPMetrics(ctx context.Context) (func(), error) {
+	if len(*otlpMetricsEndpoint) == 0 {
+		return func() {}, nil
+	}
+
+	log.Infof("Initialize OTLP metrics export (endpoint: %s)", *otlpMetricsEndpoint)
+
+	exp, err := otlpmetricgrpc.New(ctx,
+		otlpmetricgrpc.WithInsecure(),
+		otlpmetricgrpc.WithEndpoint(*otlpMetricsEndpoint),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC metrics exporter: %w", err)
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp, sdkmetric.WithInterval(*otlpMetricsInterval))),
+		sdkmetric.WithResource(resourceDefinition()),
+	)
+	global.SetMeterProvider(mp)
+
+	return func() {
+		if err := mp.Shutdown(ctx); err != nil {
+			log.Errorf("failed to shutdown MeterProvider: %v", err)
+		}
+	}, nil
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: MIT
+
+package collector
+
+// CommandForLogicalSystem scopes cmd to the logical system of the client
+func CommandForLogicalSystem(cmd string, client Client) string {
+	ls := client.LogicalSystem()
+	if ls == "" {
+		return cmd
+	}
+
+	return cmd + " logical-system " + ls
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: MIT
+
+package collector
+
+// RecordCounter is implemented by clients counting the records (e.g. interfaces, peers, sessions) parsed by a collector
+type RecordCounter interface {
+	// AddRecords adds n to the number of records parsed by the collector
+	AddRecords(n int)
+}
+
+// ReportRecords reports the number of records parsed by a collector (no-op if the client does not count records)
+func ReportRecords(client Client, n int) {
+	if c, ok := client.(RecordCounter); ok {
+		c.AddRecords(n)
+	}
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: MIT
+
+package collector
+
+// CommandForRoutingInstance scopes cmd to the routing instance of the client
+func CommandForRoutingInstance(cmd string, client Client) string {
+	ri := client.RoutingInstance()
+	if ri == "" {
+		return cmd
+	}
+
+	return cmd + " instance " + ri
+}
@@ -25,6 +25,12 @@ type Client interface {
 	// Device returns device information for the connected device
 	Device() *connector.Device
 
+	// LogicalSystem returns the logical system commands are scoped to (empty for the default logical system)
+	LogicalSystem() string
+
+	// RoutingInstance returns the routing instance commands are scoped to (empty for the default instance)
+	RoutingInstance() string
+
 	// Ctx returns the context the client is running in
 	Context() context.Context
 }
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+
+package connector
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrConnectBackoff is returned by Connect while connecting to a device is suspended after failed attempts
+var ErrConnectBackoff = fmt.Errorf("connect suspended after failed attempts")
+
+// connectBackoff suspends connection attempts to devices after failed attempts for a growing duration
+type connectBackoff struct {
+	initial time.Duration
+	max     time.Duration
+	mu      sync.Mutex
+	entries map[string]*connectBackoffEntry
+}
+
+type connectBackoffEntry struct {
+	failures   int
+	retryAfter time.Time
+}
+
+func newConnectBackoff(initial, max time.Duration) *connectBackoff {
+	return &connectBackoff{
+		initial: initial,
+		max:     max,
+		entries: make(map[string]*connectBackoffEntry),
+	}
+}
+
+// check returns an error wrapping ErrConnectBackoff if no attempt should be made to connect
+func (b *connectBackoff) check(key string, now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, found := b.entries[key]
+	if !found || !now.Before(e.retryAfter) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d failed attempts, next attempt in %s", ErrConnectBackoff, e.failures, e.retryAfter.Sub(now).Round(time.Second))
+}
+
+// failed records a failed attempt and doubles the duration attempts are suspended (up to max, 0 = no limit)
+func (b *connectBackoff) failed(key string, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, found := b.entries[key]
+	if !found {
+		e = &connectBackoffEntry{}
+		b.entries[key] = e
+	}
+
+	d := b.initial
+	for i := 0; i < e.failures && d <= math.MaxInt64/2; i++ {
+		if b.max > 0 && d >= b.max {
+			break
+		}
+		d *= 2
+	}
+	if b.max > 0 && d > b.max {
+		d = b.max
+	}
+
+	e.failures++
+	e.retryAfter = now.Add(d)
+}
+
+// succeeded resets the backoff after a successful attempt
+func (b *connectBackoff) succeeded(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.entries, key)
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+
+package connector
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectBackoff(t *testing.T) {
+	b := newConnectBackoff(10*time.Second, 30*time.Second)
+	now := time.Unix(1700000000, 0)
+
+	assert.NoError(t, b.check("router1", now), "no failed attempt")
+
+	b.failed("router1", now)
+	err := b.check("router1", now.Add(5*time.Second))
+	assert.True(t, errors.Is(err, ErrConnectBackoff), "suspended after first failure")
+	assert.NoError(t, b.check("router1", now.Add(10*time.Second)), "first backoff elapsed")
+	assert.NoError(t, b.check("router2", now), "other device")
+
+	b.failed("router1", now)
+	assert.Error(t, b.check("router1", now.Add(15*time.Second)), "backoff doubled")
+	assert.NoError(t, b.check("router1", now.Add(20*time.Second)), "second backoff elapsed")
+
+	b.failed("router1", now)
+	b.failed("router1", now)
+	assert.Error(t, b.check("router1", now.Add(25*time.Second)), "limited by max")
+	assert.NoError(t, b.check("router1", now.Add(30*time.Second)), "limited by max")
+
+	b.succeeded("router1")
+	assert.NoError(t, b.check("router1", now), "reset after success")
+}
+
+func TestConnectBackoffWithoutMax(t *testing.T) {
+	b := newConnectBackoff(10*time.Second, 0)
+	now := time.Unix(1700000000, 0)
+
+	b.failed("router1", now)
+	b.failed("router1", now)
+	b.failed("router1", now)
+	assert.Error(t, b.check("router1", now.Add(35*time.Second)), "backoff doubled without max")
+	assert.NoError(t, b.check("router1", now.Add(40*time.Second)), "third backoff elapsed")
+
+	for i := 0; i < 100; i++ {
+		b.failed("router1", now)
+	}
+	assert.Error(t, b.check("router1", now.Add(100*365*24*time.Hour)), "no overflow of the backoff")
+}
@@ -4,6 +4,7 @@ package connector
 
 import (
 	"bytes"
+	"context"
 	"net"
 	"sync"
 	"time"
@@ -21,34 +22,71 @@ type SSHConnection struct {
 	lastUsed time.Time
 	mu       sync.Mutex
 	done     chan struct{}
+	sessions chan struct{}
 }
 
 // RunCommand runs a command against the device
 func (c *SSHConnection) RunCommand(cmd string) ([]byte, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	return c.RunCommandContext(context.Background(), cmd)
+}
+
+// RunCommandContext runs a command against the device. The session is closed when ctx is done before the command finished.
+// If the maximum number of concurrent sessions to the device is reached the call waits for a free session.
+func (c *SSHConnection) RunCommandContext(ctx context.Context, cmd string) ([]byte, error) {
+	return c.runSession(ctx, func(session *ssh.Session) ([]byte, error) {
+		var b = &bytes.Buffer{}
+		session.Stdout = b
 
+		err := session.Run(cmd)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not run command")
+		}
+
+		return b.Bytes(), nil
+	})
+}
+
+// runSession runs fn in a new session to the device. The session is closed when ctx is done before fn returned.
+func (c *SSHConnection) runSession(ctx context.Context, fn func(session *ssh.Session) ([]byte, error)) ([]byte, error) {
+	select {
+	case c.sessions <- struct{}{}:
+		defer func() { <-c.sessions }()
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "could not acquire session")
+	}
+
+	c.mu.Lock()
 	c.lastUsed = time.Now()
+	client := c.client
+	c.mu.Unlock()
 
-	if c.client == nil {
+	if client == nil {
 		return nil, errors.New("not connected")
 	}
 
-	session, err := c.client.NewSession()
+	session, err := client.NewSession()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not open session")
 	}
 	defer session.Close()
 
-	var b = &bytes.Buffer{}
-	session.Stdout = b
-
-	err = session.Run(cmd)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not run command")
+	type result struct {
+		b   []byte
+		err error
 	}
 
-	return b.Bytes(), nil
+	resCh := make(chan result, 1)
+	go func() {
+		b, err := fn(session)
+		resCh <- result{b: b, err: err}
+	}()
+
+	select {
+	case res := <-resCh:
+		return res.b, res.err
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "command cancelled")
+	}
 }
 
 func (c *SSHConnection) isConnected() bool {
@@ -73,7 +111,11 @@ func (c *SSHConnection) close() {
 		c.client.Close()
 	}
 
-	c.done <- struct{}{}
+	select {
+	case c.done <- struct{}{}:
+	default:
+	}
+
 	c.conn = nil
 	c.client = nil
 }
@@ -3,7 +3,9 @@
 package connector
 
 import (
+	"context"
 	"net"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -12,9 +14,10 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
-const timeoutInSeconds = 5
+const defaultConnectTimeout = 5 * time.Second
 const defaultPort = "22"
 
 // Option defines options for the manager which are applied on creation
@@ -27,6 +30,13 @@ func WithReconnectInterval(d time.Duration) Option {
 	}
 }
 
+// WithConnectTimeout sets the timeout for establishing the TCP connection and the SSH handshake (default 5 seconds)
+func WithConnectTimeout(d time.Duration) Option {
+	return func(m *SSHConnectionManager) {
+		m.connectTimeout = d
+	}
+}
+
 // WithKeepAliveInterval sets the keep alive interval (default 10 seconds)
 func WithKeepAliveInterval(d time.Duration) Option {
 	return func(m *SSHConnectionManager) {
@@ -48,24 +58,79 @@ func WithExpiredConnectionTimeout(d time.Duration) Option {
 	}
 }
 
+// WithMaxSessionsPerDevice sets the maximum number of concurrent sessions to a single device (default 1)
+func WithMaxSessionsPerDevice(n int) Option {
+	return func(m *SSHConnectionManager) {
+		if n < 1 {
+			n = 1
+		}
+
+		m.maxSessionsPerDevice = n
+	}
+}
+
+// WithProxyDialer establishes the TCP connections to the devices (and jump hosts) using the dialer of a proxy (e.g. SOCKS5)
+func WithProxyDialer(d proxy.Dialer) Option {
+	return func(m *SSHConnectionManager) {
+		m.proxyDialer = d
+	}
+}
+
+// WithHostKeyCallback verifies the host keys of the devices (and jump hosts) using cb (default: host keys are not verified)
+func WithHostKeyCallback(cb ssh.HostKeyCallback) Option {
+	return func(m *SSHConnectionManager) {
+		m.hostKeyCallback = cb
+	}
+}
+
+// WithConnectBackoff suspends connecting to a device after a failed attempt for initial, doubled after each further failed attempt up to max (0 = no limit).
+// While suspended Connect fails immediately with ErrConnectBackoff (default: no backoff)
+func WithConnectBackoff(initial, max time.Duration) Option {
+	return func(m *SSHConnectionManager) {
+		if initial > 0 {
+			m.backoff = newConnectBackoff(initial, max)
+		}
+	}
+}
+
 // SSHConnectionManager manages SSH connections to different devices
 type SSHConnectionManager struct {
 	connections              map[string]*SSHConnection
+	connectionsMu            sync.RWMutex
 	reconnectInterval        time.Duration
+	connectTimeout           time.Duration
 	keepAliveInterval        time.Duration
 	keepAliveTimeout         time.Duration
 	expiredConnectionTimeout time.Duration
+	maxSessionsPerDevice     int
+	proxyDialer              proxy.Dialer
+	hostKeyCallback          ssh.HostKeyCallback
+	backoff                  *connectBackoff
 	locks                    map[string]*sync.Mutex
+	stats                    map[string]*ConnectionStats
+	statsMu                  sync.Mutex
+}
+
+// ConnectionStats contains information about the connection to a device
+type ConnectionStats struct {
+	// ConnectedSince is the time the current connection was established
+	ConnectedSince time.Time
+
+	// Reconnects is the number of times a new connection had to be established after the previous one was lost
+	Reconnects int
 }
 
 // NewConnectionManager creates a new connection manager
 func NewConnectionManager(opts ...Option) *SSHConnectionManager {
 	m := &SSHConnectionManager{
-		connections:       make(map[string]*SSHConnection),
-		reconnectInterval: 30 * time.Second,
-		keepAliveInterval: 10 * time.Second,
-		keepAliveTimeout:  15 * time.Second,
-		locks:             make(map[string]*sync.Mutex),
+		connections:          make(map[string]*SSHConnection),
+		reconnectInterval:    30 * time.Second,
+		connectTimeout:       defaultConnectTimeout,
+		keepAliveInterval:    10 * time.Second,
+		keepAliveTimeout:     15 * time.Second,
+		maxSessionsPerDevice: 1,
+		locks:                make(map[string]*sync.Mutex),
+		stats:                make(map[string]*ConnectionStats),
 	}
 
 	for _, opt := range opts {
@@ -76,18 +141,29 @@ func NewConnectionManager(opts ...Option) *SSHConnectionManager {
 }
 
 func (m *SSHConnectionManager) lockForDevice(device *Device) *sync.Mutex {
-	if mu, exists := m.locks[device.Host]; exists {
+	m.connectionsMu.Lock()
+	defer m.connectionsMu.Unlock()
+
+	if mu, exists := m.locks[device.connectionKey()]; exists {
 		return mu
 	}
 
 	mu := &sync.Mutex{}
-	m.locks[device.Host] = mu
+	m.locks[device.connectionKey()] = mu
 	return mu
 }
 
+func (m *SSHConnectionManager) connection(device *Device) (*SSHConnection, bool) {
+	m.connectionsMu.RLock()
+	defer m.connectionsMu.RUnlock()
+
+	c, found := m.connections[device.connectionKey()]
+	return c, found
+}
+
 // Connect connects to a device or returns an long living connection
 func (m *SSHConnectionManager) Connect(device *Device) (*SSHConnection, error) {
-	if connection, found := m.connections[device.Host]; found {
+	if connection, found := m.connection(device); found {
 		if connection.isConnected() {
 			return connection, nil
 		}
@@ -97,13 +173,76 @@ func (m *SSHConnectionManager) Connect(device *Device) (*SSHConnection, error) {
 	mu.Lock()
 	defer mu.Unlock()
 
-	if connection, found := m.connections[device.Host]; found {
+	connection, found := m.connection(device)
+	if found {
 		if connection.isConnected() {
 			return connection, nil
 		}
 	}
 
-	return m.connect(device)
+	if m.backoff != nil {
+		if err := m.backoff.check(device.connectionKey(), time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := m.connect(device)
+	if err != nil {
+		if m.backoff != nil {
+			m.backoff.failed(device.connectionKey(), time.Now())
+		}
+
+		return nil, err
+	}
+
+	if m.backoff != nil {
+		m.backoff.succeeded(device.connectionKey())
+	}
+
+	m.recordConnect(device, found)
+	return c, nil
+}
+
+func (m *SSHConnectionManager) recordConnect(device *Device, reconnect bool) {
+	m.statsMu.Lock()
+	defer m.statsMu.Unlock()
+
+	s, found := m.stats[device.connectionKey()]
+	if !found {
+		s = &ConnectionStats{}
+		m.stats[device.connectionKey()] = s
+	}
+
+	s.ConnectedSince = time.Now()
+	if reconnect {
+		s.Reconnects++
+	}
+}
+
+// Stats returns information about the connection to a device (false if no connection was established yet)
+func (m *SSHConnectionManager) Stats(device *Device) (ConnectionStats, bool) {
+	m.statsMu.Lock()
+	defer m.statsMu.Unlock()
+
+	s, found := m.stats[device.connectionKey()]
+	if !found {
+		return ConnectionStats{}, false
+	}
+
+	return *s, true
+}
+
+// IsConnected returns whether the manager holds a live connection to the device
+func (m *SSHConnectionManager) IsConnected(device *Device) bool {
+	c, found := m.connection(device)
+	if !found {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.isConnected()
 }
 
 func (m *SSHConnectionManager) connect(device *Device) (*SSHConnection, error) {
@@ -113,14 +252,18 @@ func (m *SSHConnectionManager) connect(device *Device) (*SSHConnection, error) {
 	}
 
 	c := &SSHConnection{
-		conn:   conn,
-		client: client,
-		device: device,
-		done:   make(chan struct{}),
+		conn:     conn,
+		client:   client,
+		device:   device,
+		done:     make(chan struct{}, 1),
+		sessions: make(chan struct{}, m.maxSessionsPerDevice),
 	}
 	go m.keepAlive(c)
 
-	m.connections[device.Host] = c
+	m.connectionsMu.Lock()
+	m.connections[device.connectionKey()] = c
+	m.connectionsMu.Unlock()
+	log.Debugf("Connected to %s (%s)", device, conn.RemoteAddr())
 
 	return c, nil
 }
@@ -128,51 +271,117 @@ func (m *SSHConnectionManager) connect(device *Device) (*SSHConnection, error) {
 func (m *SSHConnectionManager) connectToDevice(device *Device) (*ssh.Client, net.Conn, error) {
 	cfg := &ssh.ClientConfig{
 		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Timeout:         timeoutInSeconds * time.Second,
+		Timeout:         m.connectTimeout,
+	}
+
+	if m.hostKeyCallback != nil {
+		cfg.HostKeyCallback = m.hostKeyCallback
 	}
 
 	device.Auth(cfg)
+	device.Algorithms.apply(cfg)
 
-	host := m.tcpAddressForHost(device.Host)
+	host := m.tcpAddressForHost(device.Host, device.Port)
 
-	conn, err := net.DialTimeout("tcp", host, cfg.Timeout)
+	conn, err := m.dial(device, host, cfg.Timeout)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not open tcp connection")
+		return nil, nil, err
 	}
 
-	c, chans, reqs, err := ssh.NewClientConn(conn, host, cfg)
+	c, chans, reqs, err := handshake(conn, host, cfg)
 	if err != nil {
+		conn.Close()
 		return nil, nil, errors.Wrap(err, "could not connect to device")
 	}
 
 	return ssh.NewClient(c, chans, reqs), conn, nil
 }
 
-func (m *SSHConnectionManager) tcpAddressForHost(host string) string {
-	colonCount := strings.Count(host, ":")
+// handshake runs the SSH handshake on conn. ssh.NewClientConn does not apply the timeout of the client config, so the handshake is bounded by a deadline on conn.
+// Connections tunneled through a jump host do not support deadlines, these are closed when the timeout expires instead.
+func handshake(conn net.Conn, host string, cfg *ssh.ClientConfig) (ssh.Conn, <-chan ssh.NewChannel, <-chan *ssh.Request, error) {
+	if cfg.Timeout <= 0 {
+		return ssh.NewClientConn(conn, host, cfg)
+	}
 
-	if colonCount == 0 {
-		// either legacy IP or hostname without port
-		return host + ":" + defaultPort
+	if err := conn.SetDeadline(time.Now().Add(cfg.Timeout)); err == nil {
+		c, chans, reqs, err := ssh.NewClientConn(conn, host, cfg)
+		conn.SetDeadline(time.Time{})
+		return c, chans, reqs, err
 	}
 
-	h, p, err := net.SplitHostPort(host)
-	if err == nil {
-		return m.formatHost(h) + ":" + p
+	t := time.AfterFunc(cfg.Timeout, func() {
+		conn.Close()
+	})
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, host, cfg)
+	if !t.Stop() {
+		if err == nil {
+			c.Close()
+		}
+
+		return nil, nil, nil, errors.Errorf("handshake not completed within %v", cfg.Timeout)
+	}
+
+	return c, chans, reqs, err
+}
+
+// dial opens the connection to the device, either directly or through the jump host of the device.
+// addr contains the configured hostname, so it is resolved again on every (re)connect and a changed IP address is picked up.
+func (m *SSHConnectionManager) dial(device *Device, addr string, timeout time.Duration) (net.Conn, error) {
+	if device.ProxyJump == nil {
+		conn, err := m.dialDirect(addr, timeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open tcp connection")
+		}
+
+		return conn, nil
+	}
+
+	jump, _, err := m.connectToDevice(device.ProxyJump)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to jump host %s", device.ProxyJump)
 	}
 
-	return m.formatHost(host) + ":" + defaultPort
+	conn, err := jump.Dial("tcp", addr)
+	if err != nil {
+		jump.Close()
+		return nil, errors.Wrapf(err, "could not open connection via jump host %s", device.ProxyJump)
+	}
+
+	return &jumpConn{Conn: conn, jump: jump}, nil
 }
 
-func (m *SSHConnectionManager) formatHost(host string) string {
-	ip := net.ParseIP(host)
+func (m *SSHConnectionManager) dialDirect(addr string, timeout time.Duration) (net.Conn, error) {
+	if m.proxyDialer == nil {
+		return net.DialTimeout("tcp", addr, timeout)
+	}
 
-	if ip == nil || ip.To4() != nil {
-		// not an IP or IPv4 address
-		return host
+	cd, ok := m.proxyDialer.(proxy.ContextDialer)
+	if !ok {
+		return m.proxyDialer.Dial("tcp", addr)
 	}
 
-	return "[" + host + "]"
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return cd.DialContext(ctx, "tcp", addr)
+}
+
+// tcpAddressForHost returns the address to dial for a host given as hostname or IP address with an optional port.
+// IPv6 addresses might be enclosed in brackets. If no port is given in host, port is used (0 for the default port).
+func (m *SSHConnectionManager) tcpAddressForHost(host string, port int) string {
+	h, p, err := net.SplitHostPort(host)
+	if err != nil {
+		// no port given (or an IPv6 address without brackets)
+		h = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+		p = defaultPort
+		if port > 0 {
+			p = strconv.Itoa(port)
+		}
+	}
+
+	return net.JoinHostPort(h, p)
 }
 
 func (m *SSHConnectionManager) keepAlive(connection *SSHConnection) {
@@ -200,20 +409,78 @@ func (m *SSHConnectionManager) keepAlive(connection *SSHConnection) {
 
 func (m *SSHConnectionManager) reconnect(connection *SSHConnection) {
 	for {
-		client, conn, err := m.connectToDevice(connection.device)
+		connection.mu.Lock()
+		device := connection.device
+		connection.mu.Unlock()
+
+		client, conn, err := m.connectToDevice(device)
 		if err == nil {
+			connection.mu.Lock()
 			connection.client = client
 			connection.conn = conn
+			connection.mu.Unlock()
+
+			m.recordConnect(device, true)
+			log.Debugf("Reconnected to %s (%s)", device, conn.RemoteAddr())
 			return
 		}
 
-		log.Infof("Reconnect to %s failed: %v", connection.device, err)
+		log.Infof("Reconnect to %s failed: %v", device, err)
 		time.Sleep(m.reconnectInterval)
 	}
 }
 
+// UpdateDevice replaces the device information (e.g. changed credentials) of an established connection without closing it.
+// The updated information is used when the connection has to be re-established.
+func (m *SSHConnectionManager) UpdateDevice(device *Device) {
+	c, found := m.connection(device)
+	if !found {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.device = device
+}
+
+// Hosts returns the hosts the manager holds connections for
+func (m *SSHConnectionManager) Hosts() []string {
+	m.connectionsMu.RLock()
+	defer m.connectionsMu.RUnlock()
+
+	hosts := make([]string, 0, len(m.connections))
+	for _, c := range m.connections {
+		hosts = append(hosts, c.device.Host)
+	}
+
+	return hosts
+}
+
+// CloseForHost closes the connections to a single host and removes them from the manager
+func (m *SSHConnectionManager) CloseForHost(host string) {
+	m.connectionsMu.Lock()
+	defer m.connectionsMu.Unlock()
+
+	for key, c := range m.connections {
+		if c.device.Host != host {
+			continue
+		}
+
+		c.close()
+		delete(m.connections, key)
+
+		m.statsMu.Lock()
+		delete(m.stats, key)
+		m.statsMu.Unlock()
+	}
+}
+
 // Close closes all TCP connections and stop keep alives
 func (m *SSHConnectionManager) Close() error {
+	m.connectionsMu.RLock()
+	defer m.connectionsMu.RUnlock()
+
 	for _, c := range m.connections {
 		c.close()
 	}
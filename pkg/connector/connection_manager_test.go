@@ -3,15 +3,20 @@
 package connector
 
 import (
+	"errors"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestTCPAddressForHost(t *testing.T) {
 	tests := []struct {
 		name     string
 		host     string
+		port     int
 		expected string
 	}{
 		{
@@ -49,6 +54,39 @@ func TestTCPAddressForHost(t *testing.T) {
 			host:     "[2001:678:1e0:f00::1]:22",
 			expected: "[2001:678:1e0:f00::1]:22",
 		},
+		{
+			name:     "IPv6 with non default port",
+			host:     "[2001:db8::1]:830",
+			expected: "[2001:db8::1]:830",
+		},
+		{
+			name:     "hostname with configured port",
+			host:     "test.routing.rocks",
+			port:     2222,
+			expected: "test.routing.rocks:2222",
+		},
+		{
+			name:     "port in hostname preferred over configured port",
+			host:     "test.routing.rocks:830",
+			port:     2222,
+			expected: "test.routing.rocks:830",
+		},
+		{
+			name:     "IPv6 with configured port",
+			host:     "2001:db8::1",
+			port:     2222,
+			expected: "[2001:db8::1]:2222",
+		},
+		{
+			name:     "IPv6 loopback without brackets",
+			host:     "::1",
+			expected: "[::1]:22",
+		},
+		{
+			name:     "IPv6 link local with zone",
+			host:     "fe80::1%eth0",
+			expected: "[fe80::1%eth0]:22",
+		},
 	}
 
 	t.Parallel()
@@ -56,7 +94,162 @@ func TestTCPAddressForHost(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			m := NewConnectionManager()
-			assert.Equal(t, test.expected, m.tcpAddressForHost(test.host))
+			assert.Equal(t, test.expected, m.tcpAddressForHost(test.host, test.port))
+		})
+	}
+}
+
+func TestConnectionKey(t *testing.T) {
+	bastion := &Device{Host: "bastion"}
+	direct := &Device{Host: "router1"}
+	jumped := &Device{Host: "router1", ProxyJump: bastion}
+	nested := &Device{Host: "router1", ProxyJump: &Device{Host: "bastion2", ProxyJump: bastion}}
+
+	assert.Equal(t, "router1", direct.connectionKey())
+	assert.Equal(t, "bastion,router1", jumped.connectionKey())
+	assert.Equal(t, "bastion,bastion2,router1", nested.connectionKey())
+}
+
+func TestAlgorithmsApply(t *testing.T) {
+	cfg := &ssh.ClientConfig{}
+	var a *Algorithms
+	a.apply(cfg)
+	assert.Nil(t, cfg.Ciphers, "defaults")
+
+	a = &Algorithms{
+		Ciphers:           []string{"aes128-cbc"},
+		KeyExchanges:      []string{"diffie-hellman-group14-sha1"},
+		MACs:              []string{"hmac-sha1"},
+		HostKeyAlgorithms: []string{"ssh-rsa"},
+	}
+	a.apply(cfg)
+	assert.Equal(t, []string{"aes128-cbc"}, cfg.Ciphers)
+	assert.Equal(t, []string{"diffie-hellman-group14-sha1"}, cfg.KeyExchanges)
+	assert.Equal(t, []string{"hmac-sha1"}, cfg.MACs)
+	assert.Equal(t, []string{"ssh-rsa"}, cfg.HostKeyAlgorithms)
+}
+
+type recordingDialer struct {
+	addr string
+}
+
+func (d *recordingDialer) Dial(network, addr string) (net.Conn, error) {
+	d.addr = addr
+	return nil, errors.New("dial not possible")
+}
+
+func TestDialProxyDialer(t *testing.T) {
+	d := &recordingDialer{}
+	m := NewConnectionManager(WithProxyDialer(d))
+
+	_, err := m.dial(&Device{Host: "router1"}, "router1:22", time.Second)
+	assert.Error(t, err)
+	assert.Equal(t, "router1:22", d.addr, "dialed via proxy")
+}
+
+func TestStats(t *testing.T) {
+	m := NewConnectionManager()
+	d := &Device{Host: "router1"}
+
+	_, found := m.Stats(d)
+	assert.False(t, found, "not connected yet")
+
+	m.recordConnect(d, false)
+	s, found := m.Stats(d)
+	assert.True(t, found)
+	assert.Equal(t, 0, s.Reconnects)
+	assert.False(t, s.ConnectedSince.IsZero())
+
+	m.recordConnect(d, true)
+	s, _ = m.Stats(d)
+	assert.Equal(t, 1, s.Reconnects)
+}
+
+func TestIsConnected(t *testing.T) {
+	m := NewConnectionManager()
+	d := &Device{Host: "router1"}
+
+	assert.False(t, m.IsConnected(d), "no connection")
+
+	m.connections[d.connectionKey()] = &SSHConnection{device: d}
+	assert.False(t, m.IsConnected(d), "connection lost")
+
+	m.connections[d.connectionKey()].conn = &net.TCPConn{}
+	assert.True(t, m.IsConnected(d), "connected")
+}
+
+func TestUpdateDevice(t *testing.T) {
+	m := NewConnectionManager()
+	d := &Device{Host: "router1"}
+	conn := &net.TCPConn{}
+	m.connections[d.connectionKey()] = &SSHConnection{device: d, conn: conn}
+
+	updated := &Device{Host: "router1"}
+	m.UpdateDevice(updated)
+
+	c := m.connections[d.connectionKey()]
+	assert.Same(t, updated, c.Device(), "device replaced")
+	assert.Same(t, conn, c.conn, "connection kept")
+
+	m.UpdateDevice(&Device{Host: "router2"})
+	assert.Equal(t, 1, len(m.connections), "no connection added for unknown device")
+}
+
+func TestConnectToDeviceDialsHostname(t *testing.T) {
+	d := &recordingDialer{}
+	m := NewConnectionManager(WithProxyDialer(d))
+	device := &Device{Host: "vmx1.example.com", Auth: AuthByPassword("exporter", "secret")}
+
+	for i := 0; i < 2; i++ {
+		d.addr = ""
+		_, _, err := m.connectToDevice(device)
+		assert.Error(t, err)
+		assert.Equal(t, "vmx1.example.com:22", d.addr, "hostname dialed instead of a resolved address (attempt %d)", i+1)
+	}
+}
+
+// noDeadlineConn behaves like a connection tunneled through a jump host, which does not support deadlines
+type noDeadlineConn struct {
+	net.Conn
+}
+
+func (c *noDeadlineConn) SetDeadline(time.Time) error {
+	return errors.New("deadline not supported")
+}
+
+func TestHandshakeTimeout(t *testing.T) {
+	cfg := &ssh.ClientConfig{
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         50 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name string
+		wrap func(net.Conn) net.Conn
+	}{
+		{name: "deadline", wrap: func(c net.Conn) net.Conn { return c }},
+		{name: "no deadline", wrap: func(c net.Conn) net.Conn { return &noDeadlineConn{Conn: c} }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer server.Close()
+
+			// the server accepts the connection, but never answers
+			go func() {
+				b := make([]byte, 1024)
+				for {
+					if _, err := server.Read(b); err != nil {
+						return
+					}
+				}
+			}()
+
+			start := time.Now()
+			_, _, _, err := handshake(test.wrap(client), "router1:22", cfg)
+			assert.Error(t, err)
+			assert.Less(t, time.Since(start), 5*time.Second, "handshake bounded by timeout")
 		})
 	}
 }
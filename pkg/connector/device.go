@@ -12,6 +12,34 @@ import (
 type Device struct {
 	Host string
 	Auth AuthMethod
+
+	// Port is the SSH port of the device, used if Host does not contain a port (0 for the default port)
+	Port int
+
+	// ProxyJump is an optional intermediate host the connection to the device is established through
+	ProxyJump *Device
+
+	// Algorithms optionally restricts the algorithms negotiated with the device (nil uses the defaults of the SSH library)
+	Algorithms *Algorithms
+}
+
+// Algorithms are the SSH algorithms offered to the device, empty lists use the defaults of the SSH library
+type Algorithms struct {
+	Ciphers           []string
+	KeyExchanges      []string
+	MACs              []string
+	HostKeyAlgorithms []string
+}
+
+func (a *Algorithms) apply(cfg *ssh.ClientConfig) {
+	if a == nil {
+		return
+	}
+
+	cfg.Ciphers = a.Ciphers
+	cfg.KeyExchanges = a.KeyExchanges
+	cfg.MACs = a.MACs
+	cfg.HostKeyAlgorithms = a.HostKeyAlgorithms
 }
 
 // AuthMethod is the method to use to authenticate agaist the device
@@ -41,3 +69,12 @@ func AuthByKey(username string, key io.Reader, keyPassphrase string) (AuthMethod
 func (d *Device) String() string {
 	return d.Host
 }
+
+// connectionKey identifies the connection to the device including all intermediate hosts
+func (d *Device) connectionKey() string {
+	if d.ProxyJump == nil {
+		return d.Host
+	}
+
+	return d.ProxyJump.connectionKey() + "," + d.Host
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: MIT
+
+package connector
+
+import (
+	"net"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// jumpConn is a connection tunneled through a jump host. Closing it also closes the connection to the jump host.
+type jumpConn struct {
+	net.Conn
+	jump *ssh.Client
+}
+
+func (c *jumpConn) Close() error {
+	err := c.Conn.Close()
+	c.jump.Close()
+
+	return err
+}
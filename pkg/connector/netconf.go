@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+
+package connector
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const netconfDelimiter = "]]>]]>"
+
+const netconfHello = `<?xml version="1.0" encoding="UTF-8"?>
+<hello xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+  <capabilities>
+    <capability>urn:ietf:params:netconf:base:1.0</capability>
+  </capabilities>
+</hello>` + netconfDelimiter
+
+// RunNetconfRPCContext runs a NETCONF RPC against the device using the netconf subsystem and returns the rpc-reply.
+// The session is closed when ctx is done before the reply was received.
+func (c *SSHConnection) RunNetconfRPCContext(ctx context.Context, rpc string) ([]byte, error) {
+	return c.runSession(ctx, func(session *ssh.Session) ([]byte, error) {
+		stdin, err := session.StdinPipe()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open stdin")
+		}
+
+		stdout, err := session.StdoutPipe()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open stdout")
+		}
+
+		err = session.RequestSubsystem("netconf")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not start netconf subsystem")
+		}
+
+		r := bufio.NewReader(stdout)
+		_, err = readNetconfMessage(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read hello")
+		}
+
+		_, err = io.WriteString(stdin, netconfHello+rpc+netconfDelimiter)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not send rpc")
+		}
+
+		b, err := readNetconfMessage(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read rpc-reply")
+		}
+
+		io.WriteString(stdin, `<rpc><close-session/></rpc>`+netconfDelimiter)
+		return b, nil
+	})
+}
+
+// readNetconfMessage reads a message framed by the NETCONF 1.0 end-of-message delimiter
+func readNetconfMessage(r *bufio.Reader) ([]byte, error) {
+	var b bytes.Buffer
+
+	for {
+		chunk, err := r.ReadBytes('>')
+		b.Write(chunk)
+
+		if bytes.HasSuffix(b.Bytes(), []byte(netconfDelimiter)) {
+			return b.Bytes()[:b.Len()-len(netconfDelimiter)], nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
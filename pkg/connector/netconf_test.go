@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+
+package connector
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadNetconfMessage(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader(`<hello><capabilities/></hello>]]>]]>
+<rpc-reply><bgp-information>a > b</bgp-information></rpc-reply>]]>]]><incomplete>`))
+
+	b, err := readNetconfMessage(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "<hello><capabilities/></hello>", string(b))
+
+	b, err = readNetconfMessage(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "\n<rpc-reply><bgp-information>a > b</bgp-information></rpc-reply>", string(b))
+
+	_, err = readNetconfMessage(r)
+	assert.Error(t, err)
+}
@@ -5,4 +5,5 @@ package alarm
 type alarmCounter struct {
 	yellow float64
 	red    float64
+	active float64
 }
@@ -16,6 +16,7 @@ const prefix = "junos_alarms_"
 var (
 	alarmsYellowCount *prometheus.Desc
 	alarmsRedCount    *prometheus.Desc
+	alarmsActive      *prometheus.Desc
 	alarmDetails      *prometheus.Desc
 )
 
@@ -23,6 +24,7 @@ func init() {
 	l := []string{"target"}
 	alarmsYellowCount = prometheus.NewDesc(prefix+"yellow_count", "Number of yellow alarms (not silenced)", l, nil)
 	alarmsRedCount = prometheus.NewDesc(prefix+"red_count", "Number of red alarms (not silenced)", l, nil)
+	alarmsActive = prometheus.NewDesc(prefix+"active", "Number of active system and chassis alarms of any class (not silenced)", l, nil)
 	l = append(l, "class", "type", "description")
 	alarmDetails = prometheus.NewDesc(prefix+"set", "Alarm active with the details provided in labels", l, nil)
 }
@@ -51,6 +53,8 @@ func (*alarmCollector) Name() string {
 func (*alarmCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- alarmsYellowCount
 	ch <- alarmsRedCount
+	ch <- alarmsActive
+	ch <- alarmDetails
 }
 
 // Collect collects metrics from JunOS
@@ -62,6 +66,7 @@ func (c *alarmCollector) Collect(client collector.Client, ch chan<- prometheus.M
 
 	ch <- prometheus.MustNewConstMetric(alarmsYellowCount, prometheus.GaugeValue, counter.yellow, labelValues...)
 	ch <- prometheus.MustNewConstMetric(alarmsRedCount, prometheus.GaugeValue, counter.red, labelValues...)
+	ch <- prometheus.MustNewConstMetric(alarmsActive, prometheus.GaugeValue, counter.active, labelValues...)
 	if alarms != nil {
 		for _, alarm := range *alarms {
 			localLabelvalues := append(labelValues, alarm.Class, alarm.Type, alarm.Description)
@@ -73,9 +78,6 @@ func (c *alarmCollector) Collect(client collector.Client, ch chan<- prometheus.M
 }
 
 func (c *alarmCollector) alarmCounter(client collector.Client) (*alarmCounter, *[]details, error) {
-	red := 0
-	yellow := 0
-
 	cmds := []string{
 		"show system alarms",
 		"show chassis alarms",
@@ -83,7 +85,6 @@ func (c *alarmCollector) alarmCounter(client collector.Client) (*alarmCounter, *
 
 	var alarms []details
 
-	messages := make(map[string]interface{})
 	for _, cmd := range cmds {
 		var a = multiEngineResult{}
 		err := client.RunCommandAndParseWithParser(cmd, func(b []byte) error {
@@ -94,29 +95,43 @@ func (c *alarmCollector) alarmCounter(client collector.Client) (*alarmCounter, *
 		}
 
 		for _, engine := range a.Information.RoutingEngines {
-			for _, d := range engine.AlarmInfo.Details {
-				if _, found := messages[d.Description]; found {
-					continue
-				}
+			alarms = append(alarms, engine.AlarmInfo.Details...)
+		}
+	}
+
+	counter, alarms := c.countAlarms(alarms)
+	return counter, &alarms, nil
+}
 
-				alarms = append(alarms, d)
+// countAlarms counts the alarms not silenced by the filter and removes alarms reported multiple times (e.g. by both routing engines)
+func (c *alarmCollector) countAlarms(alarms []details) (*alarmCounter, []details) {
+	red := 0
+	yellow := 0
+	active := 0
 
-				if c.shouldFilterAlarm(&d) {
-					continue
-				}
+	unique := make([]details, 0, len(alarms))
+	messages := make(map[string]interface{})
+	for _, d := range alarms {
+		if _, found := messages[d.Description]; found {
+			continue
+		}
+
+		messages[d.Description] = nil
+		unique = append(unique, d)
 
-				if d.Class == "Major" {
-					red++
-				} else if d.Class == "Minor" {
-					yellow++
-				}
+		if c.shouldFilterAlarm(&d) {
+			continue
+		}
 
-				messages[d.Description] = nil
-			}
+		active++
+		if d.Class == "Major" {
+			red++
+		} else if d.Class == "Minor" {
+			yellow++
 		}
 	}
 
-	return &alarmCounter{red: float64(red), yellow: float64(yellow)}, &alarms, nil
+	return &alarmCounter{red: float64(red), yellow: float64(yellow), active: float64(active)}, unique
 }
 
 func (c *alarmCollector) shouldFilterAlarm(a *details) bool {
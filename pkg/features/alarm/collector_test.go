@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: MIT
+
+package alarm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountAlarms(t *testing.T) {
+	c := NewCollector("Rescue configuration").(*alarmCollector)
+
+	alarms := []details{
+		{Class: "Major", Type: "Chassis", Description: "PEM 1 Not Powered"},
+		{Class: "Major", Type: "Chassis", Description: "PEM 1 Not Powered"},
+		{Class: "Minor", Type: "Configuration", Description: "Rescue configuration is not set"},
+		{Class: "Minor", Type: "License", Description: "License color=yellow, class=CHASSIS, reason=Scale-Subscriber License(s) not installed"},
+		{Class: "Info", Type: "Boot", Description: "Boot from backup root"},
+	}
+
+	counter, unique := c.countAlarms(alarms)
+	assert.Equal(t, &alarmCounter{red: 1, yellow: 1, active: 3}, counter)
+	assert.Len(t, unique, 4, "duplicates removed, silenced alarms kept")
+
+	counter, unique = c.countAlarms(nil)
+	assert.Equal(t, &alarmCounter{}, counter, "cleared alarms")
+	assert.Empty(t, unique)
+}
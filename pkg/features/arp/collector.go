@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+
+package arp
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_"
+
+var (
+	arpEntriesDesc *prometheus.Desc
+	ndEntriesDesc  *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface"}
+	arpEntriesDesc = prometheus.NewDesc(prefix+"arp_entries_count", "Number of ARP entries per interface", l, nil)
+	ndEntriesDesc = prometheus.NewDesc(prefix+"ipv6_nd_entries_count", "Number of IPv6 neighbor discovery entries per interface", l, nil)
+}
+
+type arpCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &arpCollector{}
+}
+
+// Name returns the name of the collector
+func (*arpCollector) Name() string {
+	return "ARP"
+}
+
+// Describe describes the metrics
+func (*arpCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- arpEntriesDesc
+	ch <- ndEntriesDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *arpCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var a = arpResult{}
+	err := client.RunCommandAndParse("show arp no-resolve", &a)
+	if err != nil {
+		return err
+	}
+
+	arpCounts := make(map[string]int)
+	for _, e := range a.Information.Entries {
+		arpCounts[interfaceName(e.InterfaceName)]++
+	}
+	collectCounts(ch, arpEntriesDesc, arpCounts, labelValues)
+
+	var n = ndResult{}
+	err = client.RunCommandAndParse("show ipv6 neighbors", &n)
+	if err != nil {
+		return err
+	}
+
+	ndCounts := make(map[string]int)
+	for _, e := range n.Information.Entries {
+		ndCounts[interfaceName(e.InterfaceName)]++
+	}
+	collectCounts(ch, ndEntriesDesc, ndCounts, labelValues)
+
+	return nil
+}
+
+func collectCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, counts map[string]int, labelValues []string) {
+	for ifName, count := range counts {
+		l := append(labelValues[:len(labelValues):len(labelValues)], ifName)
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), l...)
+	}
+}
+
+// interfaceName strips the [...] suffix Junos appends to IRB and VLAN interfaces (e.g. "irb.100 [ge-0/0/1.0]")
+func interfaceName(name string) string {
+	name = strings.TrimSpace(name)
+	if i := strings.Index(name, " "); i > 0 {
+		return name[:i]
+	}
+
+	return name
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+
+package arp
+
+type arpResult struct {
+	Information struct {
+		Entries []arpEntry `xml:"arp-table-entry"`
+		Count   int64      `xml:"arp-entry-count"`
+	} `xml:"arp-table-information"`
+}
+
+type arpEntry struct {
+	InterfaceName string `xml:"interface-name"`
+}
+
+type ndResult struct {
+	Information struct {
+		Entries []ndEntry `xml:"ipv6-nd-entry"`
+	} `xml:"ipv6-nd-information"`
+}
+
+type ndEntry struct {
+	InterfaceName string `xml:"ipv6-nd-interface-name"`
+}
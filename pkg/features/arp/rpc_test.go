@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+
+package arp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseARPTable(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<arp-table-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-arp" junos:style="normal">
+    <arp-table-entry>
+        <mac-address>00:11:22:33:44:55</mac-address>
+        <ip-address>192.0.2.1</ip-address>
+        <interface-name>ge-0/0/0.0</interface-name>
+        <arp-table-entry-flags>
+            <none/>
+        </arp-table-entry-flags>
+    </arp-table-entry>
+    <arp-table-entry>
+        <mac-address>00:11:22:33:44:66</mac-address>
+        <ip-address>192.0.2.2</ip-address>
+        <interface-name>ge-0/0/0.0</interface-name>
+    </arp-table-entry>
+    <arp-table-entry>
+        <mac-address>00:11:22:33:44:77</mac-address>
+        <ip-address>198.51.100.1</ip-address>
+        <interface-name>irb.100 [ge-0/0/1.0]</interface-name>
+    </arp-table-entry>
+    <arp-entry-count>3</arp-entry-count>
+</arp-table-information>
+</rpc-reply>`
+
+	var x arpResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(3), x.Information.Count, "count")
+	assert.Equal(t, 3, len(x.Information.Entries), "entries")
+	assert.Equal(t, "ge-0/0/0.0", interfaceName(x.Information.Entries[0].InterfaceName), "interface")
+	assert.Equal(t, "irb.100", interfaceName(x.Information.Entries[2].InterfaceName), "irb interface")
+}
+
+func TestParseNDTable(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<ipv6-nd-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-routing">
+    <ipv6-nd-entry>
+        <ipv6-nd-neighbor-address>2001:db8::1</ipv6-nd-neighbor-address>
+        <ipv6-nd-neighbor-l2-address>00:11:22:33:44:55</ipv6-nd-neighbor-l2-address>
+        <ipv6-nd-state>reachable</ipv6-nd-state>
+        <ipv6-nd-expire>17</ipv6-nd-expire>
+        <ipv6-nd-isrouter>yes</ipv6-nd-isrouter>
+        <ipv6-nd-issecure>no</ipv6-nd-issecure>
+        <ipv6-nd-interface-name>ge-0/0/0.0</ipv6-nd-interface-name>
+    </ipv6-nd-entry>
+    <ipv6-nd-entry>
+        <ipv6-nd-neighbor-address>fe80::211:22ff:fe33:4455</ipv6-nd-neighbor-address>
+        <ipv6-nd-state>stale</ipv6-nd-state>
+        <ipv6-nd-interface-name>ge-0/0/0.0</ipv6-nd-interface-name>
+    </ipv6-nd-entry>
+</ipv6-nd-information>
+</rpc-reply>`
+
+	var x ndResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Entries), "entries")
+	assert.Equal(t, "ge-0/0/0.0", x.Information.Entries[1].InterfaceName, "interface")
+}
@@ -10,16 +10,26 @@ import (
 const prefix = "junos_bfd_"
 
 var (
-	bfdState    *prometheus.Desc
-	bfdStateMap = map[string]int{
+	bfdState                *prometheus.Desc
+	bfdDetectionTime        *prometheus.Desc
+	bfdTransmissionInterval *prometheus.Desc
+	bfdReceptionInterval    *prometheus.Desc
+	bfdMultiplier           *prometheus.Desc
+	bfdFlapCount            *prometheus.Desc
+	bfdStateMap             = map[string]int{
 		"Down": 0,
 		"Up":   1,
 	}
 )
 
 func init() {
-	l := []string{"target", "neighbor", "interface", "client"}
+	l := []string{"target", "neighbor", "interface", "client", "type"}
 	bfdState = prometheus.NewDesc(prefix+"state", "bfd state (0: down, 1:up)", l, nil)
+	bfdDetectionTime = prometheus.NewDesc(prefix+"detection_time_seconds", "Time after the session is declared down when no packets were received", l, nil)
+	bfdTransmissionInterval = prometheus.NewDesc(prefix+"transmit_interval_seconds", "Interval packets are transmitted in", l, nil)
+	bfdReceptionInterval = prometheus.NewDesc(prefix+"receive_interval_seconds", "Minimum interval packets are expected to be received in", l, nil)
+	bfdMultiplier = prometheus.NewDesc(prefix+"multiplier", "Number of missed packets after the session is declared down", l, nil)
+	bfdFlapCount = prometheus.NewDesc(prefix+"flap_count", "Number of session flaps", l, nil)
 }
 
 type bfdCollector struct {
@@ -38,20 +48,40 @@ func NewCollector() collector.RPCCollector {
 // Describe describes the metrics
 func (*bfdCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- bfdState
+	ch <- bfdDetectionTime
+	ch <- bfdTransmissionInterval
+	ch <- bfdReceptionInterval
+	ch <- bfdMultiplier
+	ch <- bfdFlapCount
 }
 
 // Collect collects metrics from JunOS
 func (c *bfdCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	var res = result{}
-	err := client.RunCommandAndParse("show bfd session extensive", &res)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show bfd session extensive", client), &res)
 	if err != nil {
 		return err
 	}
 
+	collector.ReportRecords(client, len(res.Information.BfdSessions))
 	for _, bfds := range res.Information.BfdSessions {
-		l := append(labelValues, bfds.Neighbor, bfds.Interface, bfds.Client.Name)
+		l := append(labelValues, bfds.Neighbor, bfds.Interface, bfds.Client.Name, sessionType(bfds))
 		ch <- prometheus.MustNewConstMetric(bfdState, prometheus.GaugeValue, float64(bfdStateMap[bfds.State]), l...)
+		ch <- prometheus.MustNewConstMetric(bfdDetectionTime, prometheus.GaugeValue, bfds.DetectionTime, l...)
+		ch <- prometheus.MustNewConstMetric(bfdTransmissionInterval, prometheus.GaugeValue, bfds.TransmissionInterval, l...)
+		ch <- prometheus.MustNewConstMetric(bfdReceptionInterval, prometheus.GaugeValue, bfds.ReceptionInterval, l...)
+		ch <- prometheus.MustNewConstMetric(bfdMultiplier, prometheus.GaugeValue, float64(bfds.Multiplier), l...)
+		ch <- prometheus.MustNewConstMetric(bfdFlapCount, prometheus.CounterValue, float64(bfds.FlapCount), l...)
 	}
 
 	return nil
 }
+
+// sessionType returns whether the session is single or multi hop. Multi hop sessions are not bound to an interface.
+func sessionType(s session) string {
+	if s.Interface == "" {
+		return "multi-hop"
+	}
+
+	return "single-hop"
+}
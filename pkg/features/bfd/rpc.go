@@ -13,10 +13,15 @@ type result struct {
 }
 
 type session struct {
-	Neighbor  string `xml:"session-neighbor"`
-	State     string `xml:"session-state"`
-	Interface string `xml:"session-interface"`
-	Client    struct {
+	Neighbor             string  `xml:"session-neighbor"`
+	State                string  `xml:"session-state"`
+	Interface            string  `xml:"session-interface"`
+	DetectionTime        float64 `xml:"session-detection-time"`
+	TransmissionInterval float64 `xml:"session-transmission-interval"`
+	ReceptionInterval    float64 `xml:"session-minimum-reception-interval"`
+	Multiplier           int64   `xml:"session-adaptive-multiplier"`
+	FlapCount            int64   `xml:"session-flap-count"`
+	Client               struct {
 		Name string `xml:"client-name"`
 	} `xml:"bfd-client"`
 }
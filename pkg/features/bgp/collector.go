@@ -31,6 +31,10 @@ var (
 	medDesc                     *prometheus.Desc
 	preferenceDesc              *prometheus.Desc
 	holdTimeDesc                *prometheus.Desc
+	addPathReceiveDesc          *prometheus.Desc
+	addPathSendPathsDesc        *prometheus.Desc
+	groupPeersDesc              *prometheus.Desc
+	groupEstablishedPeersDesc   *prometheus.Desc
 )
 
 func init() {
@@ -56,17 +60,22 @@ func init() {
 	advertisedPrefixesDesc = prometheus.NewDesc(prefix+"prefixes_advertised_count", "Number of prefixes announced to peer", l, nil)
 	prefixesLimitPercentageDesc = prometheus.NewDesc(prefix+"prefixes_limit_percentage", "percentage of received prefixes against prefix-limit", l, nil)
 	prefixesLimitCountDesc = prometheus.NewDesc(prefix+"prefixes_limit_count", "prefix-count variable set in prefix-limit", l, nil)
+	addPathReceiveDesc = prometheus.NewDesc(prefix+"addpath_receive", "Receiving multiple paths per prefix (add-path) is negotiated with the peer (1 = negotiated)", l, nil)
+	addPathSendPathsDesc = prometheus.NewDesc(prefix+"addpath_send_paths", "Number of paths per prefix sent to the peer (add-path), 0 if negotiated without a path count", l, nil)
+
+	groupLabels := []string{"target", "group", "type"}
+	groupPeersDesc = prometheus.NewDesc("junos_bgp_group_peers_count", "Number of peers configured in the group", groupLabels, nil)
+	groupEstablishedPeersDesc = prometheus.NewDesc("junos_bgp_group_established_peers_count", "Number of peers in the group with an established session", groupLabels, nil)
 }
 
 type bgpCollector struct {
-	LogicalSystem string
 }
 
 type groupMap map[int64]group
 
 // NewCollector creates a new collector
-func NewCollector(logicalSystem string) collector.RPCCollector {
-	return &bgpCollector{LogicalSystem: logicalSystem}
+func NewCollector() collector.RPCCollector {
+	return &bgpCollector{}
 }
 
 // Name returns the name of the collector
@@ -91,6 +100,10 @@ func (*bgpCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- medDesc
 	ch <- preferenceDesc
 	ch <- holdTimeDesc
+	ch <- addPathReceiveDesc
+	ch <- addPathSendPathsDesc
+	ch <- groupPeersDesc
+	ch <- groupEstablishedPeersDesc
 }
 
 // Collect collects metrics from JunOS
@@ -105,13 +118,7 @@ func (c *bgpCollector) Collect(client collector.Client, ch chan<- prometheus.Met
 
 func (c *bgpCollector) collectGroups(client collector.Client) (groupMap, error) {
 	var x = groupResult{}
-	var cmd strings.Builder
-	cmd.WriteString("show bgp group")
-	if c.LogicalSystem != "" {
-		cmd.WriteString(" logical-system " + c.LogicalSystem)
-	}
-
-	err := client.RunCommandAndParse(cmd.String(), &x)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show bgp group", client), client), &x)
 	if err != nil {
 		return nil, err
 	}
@@ -130,18 +137,15 @@ func (c *bgpCollector) collect(client collector.Client, ch chan<- prometheus.Met
 		return fmt.Errorf("could not retrieve BGP group information: %w", err)
 	}
 
-	var x = result{}
-	var cmd strings.Builder
-	cmd.WriteString("show bgp neighbor")
-	if c.LogicalSystem != "" {
-		cmd.WriteString(" logical-system " + c.LogicalSystem)
-	}
+	c.collectForGroups(groups, ch, labelValues)
 
-	err = client.RunCommandAndParse(cmd.String(), &x)
+	var x = result{}
+	err = client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show bgp neighbor", client), client), &x)
 	if err != nil {
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.Peers))
 	for _, peer := range x.Information.Peers {
 		c.collectForPeer(peer, groups, ch, labelValues)
 	}
@@ -149,6 +153,14 @@ func (c *bgpCollector) collect(client collector.Client, ch chan<- prometheus.Met
 	return nil
 }
 
+func (c *bgpCollector) collectForGroups(groups groupMap, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, g := range groups {
+		l := append(labelValues[:len(labelValues):len(labelValues)], g.Name, strings.ToLower(g.Type))
+		ch <- prometheus.MustNewConstMetric(groupPeersDesc, prometheus.GaugeValue, float64(g.PeerCount), l...)
+		ch <- prometheus.MustNewConstMetric(groupEstablishedPeersDesc, prometheus.GaugeValue, float64(g.EstablishedCount), l...)
+	}
+}
+
 func (c *bgpCollector) collectForPeer(p peer, groups groupMap, ch chan<- prometheus.Metric, labelValues []string) {
 	ip := strings.Split(p.IP, "+")
 	l := append(labelValues, []string{
@@ -179,28 +191,14 @@ func (c *bgpCollector) collectForPeer(p peer, groups groupMap, ch chan<- prometh
 	ch <- prometheus.MustNewConstMetric(infoDesc, prometheus.GaugeValue, 1, infoValues...)
 
 	c.collectRIBForPeer(p, ch, l)
+	c.collectAddPathForPeer(p, ch, l)
 }
 
 func (*bgpCollector) collectRIBForPeer(p peer, ch chan<- prometheus.Metric, labelValues []string) {
-	var rib_name string
-
-	// derive the name of the rib for which the prefix limit is configured by examining the NLRI type
-	switch nlri_type := p.OptionInformation.PrefixLimit.NlriType; nlri_type {
-	case "inet-unicast":
-		rib_name = "inet.0"
-	case "inet6-unicast":
-		rib_name = "inet6.0"
-	default:
-		rib_name = ""
-	}
-
-	// if the prefix limit is configured inside a routing instance we need to prepend the RTI name to the rib name
-	if p.CFGRTI != "" && p.CFGRTI != "master" && rib_name != "" {
-		rib_name = p.CFGRTI + "." + rib_name
-	}
+	limits := prefixLimitsForPeer(p)
 
-	if p.OptionInformation.PrefixLimit.PrefixCount > 0 {
-		ch <- prometheus.MustNewConstMetric(prefixesLimitCountDesc, prometheus.GaugeValue, float64(p.OptionInformation.PrefixLimit.PrefixCount), append(labelValues, rib_name)...)
+	for ribName, limit := range limits {
+		ch <- prometheus.MustNewConstMetric(prefixesLimitCountDesc, prometheus.GaugeValue, float64(limit), append(labelValues, ribName)...)
 	}
 
 	for _, rib := range p.RIBs {
@@ -211,11 +209,22 @@ func (*bgpCollector) collectRIBForPeer(p peer, ch chan<- prometheus.Metric, labe
 		ch <- prometheus.MustNewConstMetric(activePrefixesDesc, prometheus.GaugeValue, float64(rib.ActivePrefixes), l...)
 		ch <- prometheus.MustNewConstMetric(advertisedPrefixesDesc, prometheus.GaugeValue, float64(rib.AdvertisedPrefixes), l...)
 
-		if rib.Name == rib_name {
-			if p.OptionInformation.PrefixLimit.PrefixCount > 0 {
-				prefixesLimitPercent := float64(rib.ReceivedPrefixes) / float64(p.OptionInformation.PrefixLimit.PrefixCount)
-				ch <- prometheus.MustNewConstMetric(prefixesLimitPercentageDesc, prometheus.GaugeValue, math.Round(prefixesLimitPercent*100)/100, l...)
-			}
+		if limit, found := limits[rib.Name]; found {
+			prefixesLimitPercent := float64(rib.ReceivedPrefixes) / float64(limit)
+			ch <- prometheus.MustNewConstMetric(prefixesLimitPercentageDesc, prometheus.GaugeValue, math.Round(prefixesLimitPercent*100)/100, l...)
 		}
 	}
 }
+
+func (*bgpCollector) collectAddPathForPeer(p peer, ch chan<- prometheus.Metric, labelValues []string) {
+	receive := addPathTables(p.AddPathReceive, p.CFGRTI)
+	send := addPathTables(p.AddPathSend, p.CFGRTI)
+
+	for ribName := range receive {
+		ch <- prometheus.MustNewConstMetric(addPathReceiveDesc, prometheus.GaugeValue, 1, append(labelValues, ribName)...)
+	}
+
+	for ribName, paths := range send {
+		ch <- prometheus.MustNewConstMetric(addPathSendPathsDesc, prometheus.GaugeValue, float64(paths), append(labelValues, ribName)...)
+	}
+}
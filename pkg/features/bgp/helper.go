@@ -47,3 +47,71 @@ func localASNForPeer(p peer) string {
 
 	return strconv.FormatInt(p.OptionInformation.LocalSystemAs, 10)
 }
+
+// addPathTables returns the number of paths per prefix by rib name for a list of NLRI types with add-path negotiated (e.g. "inet-unicast(4) inet6-unicast").
+// If no path count is given for a NLRI type, 0 is returned as count.
+func addPathTables(nlriTypes, rti string) map[string]int64 {
+	tables := make(map[string]int64)
+
+	for _, t := range strings.Fields(nlriTypes) {
+		var count int64
+		if i := strings.Index(t, "("); i > 0 && strings.HasSuffix(t, ")") {
+			count, _ = strconv.ParseInt(t[i+1:len(t)-1], 10, 64)
+			t = t[:i]
+		}
+
+		ribName := ribForNLRIType(t, rti)
+		if ribName == "" {
+			continue
+		}
+
+		tables[ribName] = count
+	}
+
+	return tables
+}
+
+// ribForNLRIType derives the name of the rib for which a prefix limit is configured by examining the NLRI type
+func ribForNLRIType(nlriType, rti string) string {
+	var ribName string
+
+	switch nlriType {
+	case "inet-unicast":
+		ribName = "inet.0"
+	case "inet6-unicast":
+		ribName = "inet6.0"
+	case "inet-vpn-unicast":
+		return "bgp.l3vpn.0"
+	case "inet6-vpn-unicast":
+		return "bgp.l3vpn-inet6.0"
+	case "l2vpn-signaling":
+		return "bgp.l2vpn.0"
+	case "evpn":
+		return "bgp.evpn.0"
+	default:
+		return ""
+	}
+
+	// if the prefix limit is configured inside a routing instance we need to prepend the RTI name to the rib name
+	if rti != "" && rti != "master" {
+		ribName = rti + "." + ribName
+	}
+
+	return ribName
+}
+
+// prefixLimitsForPeer returns the configured prefix limits of a peer by rib name
+func prefixLimitsForPeer(p peer) map[string]int64 {
+	limits := make(map[string]int64)
+
+	for _, pl := range p.OptionInformation.PrefixLimits {
+		ribName := ribForNLRIType(pl.NlriType, p.CFGRTI)
+		if ribName == "" || pl.PrefixCount <= 0 {
+			continue
+		}
+
+		limits[ribName] = pl.PrefixCount
+	}
+
+	return limits
+}
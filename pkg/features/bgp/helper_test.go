@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+
+package bgp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixLimitsForPeer(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+		<bgp-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-routing">
+			<bgp-peer junos:style="detail">
+				<peer-address>2001:db8::2+179</peer-address>
+				<peer-as>65001</peer-as>
+				<peer-cfg-rti>customer1</peer-cfg-rti>
+				<bgp-option-information>
+					<prefix-limit>
+						<nlri-type>inet-unicast</nlri-type>
+						<prefix-count>1000</prefix-count>
+					</prefix-limit>
+					<prefix-limit>
+						<nlri-type>inet6-unicast</nlri-type>
+						<prefix-count>500</prefix-count>
+					</prefix-limit>
+				</bgp-option-information>
+				<bgp-rib>
+					<name>customer1.inet.0</name>
+					<received-prefix-count>10</received-prefix-count>
+				</bgp-rib>
+				<bgp-rib>
+					<name>customer1.inet6.0</name>
+					<received-prefix-count>20</received-prefix-count>
+				</bgp-rib>
+			</bgp-peer>
+		</bgp-information>
+	</rpc-reply>`
+
+	rpc := result{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := rpc.Information.Peers[0]
+	assert.Equal(t, 2, len(p.RIBs), "ribs")
+
+	limits := prefixLimitsForPeer(p)
+	assert.Equal(t, map[string]int64{
+		"customer1.inet.0":  1000,
+		"customer1.inet6.0": 500,
+	}, limits)
+}
+
+func TestRIBForNLRIType(t *testing.T) {
+	assert.Equal(t, "inet.0", ribForNLRIType("inet-unicast", "master"))
+	assert.Equal(t, "inet6.0", ribForNLRIType("inet6-unicast", ""))
+	assert.Equal(t, "vrf1.inet6.0", ribForNLRIType("inet6-unicast", "vrf1"))
+	assert.Equal(t, "bgp.l3vpn.0", ribForNLRIType("inet-vpn-unicast", "master"))
+	assert.Equal(t, "bgp.evpn.0", ribForNLRIType("evpn", "master"))
+	assert.Equal(t, "bgp.l2vpn.0", ribForNLRIType("l2vpn-signaling", "master"))
+	assert.Equal(t, "", ribForNLRIType("inet-mdt", "master"))
+}
+
+func TestAddPathTables(t *testing.T) {
+	assert.Equal(t, map[string]int64{
+		"inet.0":  4,
+		"inet6.0": 0,
+	}, addPathTables("inet-unicast(4) inet6-unicast", "master"))
+	assert.Equal(t, map[string]int64{"vrf1.inet.0": 2}, addPathTables(" inet-unicast(2) inet-mdt\n", "vrf1"))
+	assert.Empty(t, addPathTables("", "master"))
+}
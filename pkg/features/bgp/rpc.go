@@ -21,6 +21,8 @@ type peer struct {
 	OutputMessages    int64             `xml:"output-messages"`
 	RIBs              []rib             `xml:"bgp-rib"`
 	OptionInformation optionInformation `xml:"bgp-option-information"`
+	AddPathReceive    string            `xml:"nlri-addpath-receive"`
+	AddPathSend       string            `xml:"nlri-addpath-send"`
 }
 
 type rib struct {
@@ -33,17 +35,17 @@ type rib struct {
 }
 
 type optionInformation struct {
-	ExportPolicy    string      `xml:"export-policy"`
-	ImportPolicy    string      `xml:"import-policy"`
-	AddressFamilies string      `xml:"address-families"`
-	LocalAddress    string      `xml:"local-address"`
-	Holdtime        int64       `xml:"holdtime"`
-	MetricOut       int64       `xml:"metric-out"`
-	Preference      int64       `xml:"preference"`
-	PrefixLimit     prefixLimit `xml:"prefix-limit"`
-	LocalAs         int64       `xml:"local-as"`
-	LocalSystemAs   int64       `xml:"local-system-as"`
-	Options         string      `xml:"bgp-options"`
+	ExportPolicy    string        `xml:"export-policy"`
+	ImportPolicy    string        `xml:"import-policy"`
+	AddressFamilies string        `xml:"address-families"`
+	LocalAddress    string        `xml:"local-address"`
+	Holdtime        int64         `xml:"holdtime"`
+	MetricOut       int64         `xml:"metric-out"`
+	Preference      int64         `xml:"preference"`
+	PrefixLimits    []prefixLimit `xml:"prefix-limit"`
+	LocalAs         int64         `xml:"local-as"`
+	LocalSystemAs   int64         `xml:"local-system-as"`
+	Options         string        `xml:"bgp-options"`
 }
 
 type prefixLimit struct {
@@ -59,6 +61,9 @@ type groupResult struct {
 	} `xml:"bgp-group-information"`
 }
 type group struct {
-	Index int64  `xml:"group-index"`
-	Name  string `xml:"name"`
+	Index            int64  `xml:"group-index"`
+	Name             string `xml:"name"`
+	Type             string `xml:"type"`
+	PeerCount        int64  `xml:"peer-count"`
+	EstablishedCount int64  `xml:"established-count"`
 }
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+
+package bgp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGroups(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+    <bgp-group-information xmlns="http://xml.juniper.net/junos/21.4R3/junos/routing">
+        <bgp-group>
+            <type>Internal</type>
+            <name>rr-clients</name>
+            <group-index>0</group-index>
+            <peer-address>192.0.2.1</peer-address>
+            <peer-address>192.0.2.2</peer-address>
+            <peer-address>192.0.2.3</peer-address>
+            <local-as>65000</local-as>
+            <flap-count>4</flap-count>
+            <peer-count>3</peer-count>
+            <established-count>2</established-count>
+        </bgp-group>
+        <bgp-group>
+            <type>External</type>
+            <name>transit</name>
+            <group-index>1</group-index>
+            <peer-address>198.51.100.1</peer-address>
+            <local-as>65000</local-as>
+            <flap-count>0</flap-count>
+            <peer-count>1</peer-count>
+            <established-count>1</established-count>
+        </bgp-group>
+    </bgp-group-information>
+</rpc-reply>`
+
+	x := groupResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(x.Information.Groups))
+
+	g := x.Information.Groups[0]
+	assert.Equal(t, "rr-clients", g.Name, "name")
+	assert.Equal(t, "Internal", g.Type, "type")
+	assert.Equal(t, int64(3), g.PeerCount, "peer-count")
+	assert.Equal(t, int64(2), g.EstablishedCount, "established-count")
+
+	g = x.Information.Groups[1]
+	assert.Equal(t, int64(1), g.Index, "group-index")
+	assert.Equal(t, "External", g.Type, "type")
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+
+package bgpdamping
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_bgp_damping_"
+
+var (
+	suppressedRoutesDesc *prometheus.Desc
+	historyRoutesDesc    *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "neighbor"}
+	suppressedRoutesDesc = prometheus.NewDesc(prefix+"suppressed_routes_count", "Number of routes learned from the neighbor suppressed by route flap damping", l, nil)
+	historyRoutesDesc = prometheus.NewDesc(prefix+"history_routes_count", "Number of withdrawn routes learned from the neighbor kept as damping history entries", l, nil)
+}
+
+type dampingCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &dampingCollector{}
+}
+
+// Name returns the name of the collector
+func (*dampingCollector) Name() string {
+	return "BGP Damping"
+}
+
+// Describe describes the metrics
+func (*dampingCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- suppressedRoutesDesc
+	ch <- historyRoutesDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *dampingCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	cmds := []struct {
+		cmd  string
+		desc *prometheus.Desc
+	}{
+		{cmd: "show route damping suppressed detail", desc: suppressedRoutesDesc},
+		{cmd: "show route damping history detail", desc: historyRoutesDesc},
+	}
+
+	for _, t := range cmds {
+		var x = routeResult{}
+		err := client.RunCommandAndParse(t.cmd, &x)
+		if err != nil {
+			return err
+		}
+
+		for neighbor, count := range routesByNeighbor(&x) {
+			l := append(labelValues[:len(labelValues):len(labelValues)], neighbor)
+			ch <- prometheus.MustNewConstMetric(t.desc, prometheus.GaugeValue, float64(count), l...)
+		}
+	}
+
+	return nil
+}
+
+// routesByNeighbor counts the BGP route entries of all tables by the neighbor the route was learned from
+func routesByNeighbor(x *routeResult) map[string]int {
+	counts := make(map[string]int)
+	for _, t := range x.Information.Tables {
+		for _, r := range t.Routes {
+			for _, e := range r.Entries {
+				if e.Protocol != "BGP" || e.Source == "" {
+					continue
+				}
+
+				counts[e.Source]++
+			}
+		}
+	}
+
+	return counts
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+
+package bgpdamping
+
+type routeResult struct {
+	Information struct {
+		Tables []routeTable `xml:"route-table"`
+	} `xml:"route-information"`
+}
+
+type routeTable struct {
+	Name   string  `xml:"table-name"`
+	Routes []route `xml:"rt"`
+}
+
+type route struct {
+	Destination string       `xml:"rt-destination"`
+	Entries     []routeEntry `xml:"rt-entry"`
+}
+
+type routeEntry struct {
+	Protocol string `xml:"protocol-name"`
+	Source   string `xml:"gateway"`
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+
+package bgpdamping
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDampedRoutes(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<route-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-routing">
+    <route-table>
+        <table-name>inet.0</table-name>
+        <rt junos:style="detail">
+            <rt-destination>198.51.100.0</rt-destination>
+            <rt-prefix-length>24</rt-prefix-length>
+            <rt-entry>
+                <protocol-name>BGP</protocol-name>
+                <preference>170</preference>
+                <gateway>192.0.2.1</gateway>
+                <peer-as>64500</peer-as>
+            </rt-entry>
+        </rt>
+        <rt junos:style="detail">
+            <rt-destination>203.0.113.0</rt-destination>
+            <rt-prefix-length>24</rt-prefix-length>
+            <rt-entry>
+                <protocol-name>BGP</protocol-name>
+                <gateway>192.0.2.1</gateway>
+            </rt-entry>
+            <rt-entry>
+                <protocol-name>BGP</protocol-name>
+                <gateway>192.0.2.2</gateway>
+            </rt-entry>
+        </rt>
+    </route-table>
+    <route-table>
+        <table-name>inet6.0</table-name>
+        <rt junos:style="detail">
+            <rt-destination>2001:db8:100::</rt-destination>
+            <rt-prefix-length>48</rt-prefix-length>
+            <rt-entry>
+                <protocol-name>BGP</protocol-name>
+                <gateway>2001:db8::1</gateway>
+            </rt-entry>
+        </rt>
+    </route-table>
+</route-information>
+</rpc-reply>`
+
+	var x routeResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Tables), "table count")
+	assert.Equal(t, "203.0.113.0", x.Information.Tables[0].Routes[1].Destination, "rt-destination")
+
+	counts := routesByNeighbor(&x)
+	assert.Equal(t, map[string]int{"192.0.2.1": 2, "192.0.2.2": 1, "2001:db8::1": 1}, counts)
+}
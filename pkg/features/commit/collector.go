@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+
+package commit
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_"
+
+var (
+	lastCommitDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "user", "client"}
+	lastCommitDesc = prometheus.NewDesc(prefix+"last_commit_timestamp_seconds", "Unix timestamp of the last commit of the configuration", l, nil)
+}
+
+type commitCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &commitCollector{}
+}
+
+// Name returns the name of the collector
+func (*commitCollector) Name() string {
+	return "Commit"
+}
+
+// Describe describes the metrics
+func (*commitCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lastCommitDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *commitCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show system commit", &x)
+	if err != nil {
+		return err
+	}
+
+	last := lastCommit(&x)
+	if last == nil {
+		return nil
+	}
+
+	l := append(labelValues[:len(labelValues):len(labelValues)], last.User, last.Client)
+	ch <- prometheus.MustNewConstMetric(lastCommitDesc, prometheus.GaugeValue, float64(last.DateTime.Seconds), l...)
+
+	return nil
+}
+
+// lastCommit returns the commit currently active (sequence number 0), nil if the history is empty
+func lastCommit(x *result) *commitHistory {
+	for i, h := range x.Information.History {
+		if h.SequenceNumber == 0 {
+			return &x.Information.History[i]
+		}
+	}
+
+	return nil
+}
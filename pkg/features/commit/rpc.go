@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+
+package commit
+
+type result struct {
+	Information struct {
+		History []commitHistory `xml:"commit-history"`
+	} `xml:"commit-information"`
+}
+
+type commitHistory struct {
+	SequenceNumber int    `xml:"sequence-number"`
+	User           string `xml:"user"`
+	Client         string `xml:"client"`
+	DateTime       struct {
+		Seconds int64  `xml:"seconds,attr"`
+		Value   string `xml:",chardata"`
+	} `xml:"date-time"`
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+
+package commit
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommitHistory(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<commit-information>
+    <commit-history>
+        <sequence-number>0</sequence-number>
+        <user>admin</user>
+        <client>cli</client>
+        <date-time junos:seconds="1684172206">2023-05-15 17:36:46 UTC</date-time>
+        <log>maintenance</log>
+    </commit-history>
+    <commit-history>
+        <sequence-number>1</sequence-number>
+        <user>automation</user>
+        <client>netconf</client>
+        <date-time junos:seconds="1684085806">2023-05-14 17:36:46 UTC</date-time>
+    </commit-history>
+</commit-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.History), "commit count")
+
+	last := lastCommit(&x)
+	assert.NotNil(t, last)
+	assert.Equal(t, "admin", last.User, "user")
+	assert.Equal(t, "cli", last.Client, "client")
+	assert.Equal(t, int64(1684172206), last.DateTime.Seconds, "date-time")
+
+	assert.Nil(t, lastCommit(&result{}), "empty history")
+}
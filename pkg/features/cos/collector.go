@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+
+package cos
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/parse"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_cos_"
+
+var (
+	bindingInfoDesc        *prometheus.Desc
+	shapingRateDesc        *prometheus.Desc
+	shapingRatePercentDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface", "type", "subtype", "name"}
+	bindingInfoDesc = prometheus.NewDesc(prefix+"interface_binding_info", "Class of service object (e.g. classifier, rewrite rule, scheduler map) bound to the interface", l, nil)
+
+	l = []string{"target", "interface", "forwarding_class"}
+	shapingRateDesc = prometheus.NewDesc(prefix+"interface_shaping_rate_bps", "Shaping rate of the forwarding class on the interface as set in the bound scheduler map (bits per second)", l, nil)
+	shapingRatePercentDesc = prometheus.NewDesc(prefix+"interface_shaping_rate_percent", "Shaping rate of the forwarding class on the interface as set in the bound scheduler map (percent of the interface rate)", l, nil)
+}
+
+type cosCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &cosCollector{}
+}
+
+// Name returns the name of the collector
+func (*cosCollector) Name() string {
+	return "CoS"
+}
+
+// Describe describes the metrics
+func (*cosCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- bindingInfoDesc
+	ch <- shapingRateDesc
+	ch <- shapingRatePercentDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *cosCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show class-of-service interface", &x)
+	if err != nil {
+		return err
+	}
+
+	schedulerMaps := make(map[string]string)
+	for _, iface := range x.Information.Interfaces {
+		collectBindings(ch, iface.Name, iface.Objects, labelValues)
+		addSchedulerMap(schedulerMaps, iface.Name, iface.Objects)
+
+		for _, logical := range iface.LogicalInterfaces {
+			collectBindings(ch, logical.Name, logical.Objects, labelValues)
+			addSchedulerMap(schedulerMaps, logical.Name, logical.Objects)
+		}
+	}
+
+	if len(schedulerMaps) == 0 {
+		return nil
+	}
+
+	return c.collectShapingRates(client, ch, schedulerMaps, labelValues)
+}
+
+// collectShapingRates exports the shaping rates of the forwarding classes per interface, schedulerMaps contains the name of the scheduler map bound to an interface
+func (c *cosCollector) collectShapingRates(client collector.Client, ch chan<- prometheus.Metric, schedulerMaps map[string]string, labelValues []string) error {
+	var x = schedulerMapResult{}
+	err := client.RunCommandAndParse("show class-of-service scheduler-map", &x)
+	if err != nil {
+		return err
+	}
+
+	maps := make(map[string]schedulerMap)
+	for _, m := range x.Information.SchedulerMaps {
+		maps[strings.TrimSpace(m.Name)] = m
+	}
+
+	for ifName, mapName := range schedulerMaps {
+		m, found := maps[mapName]
+		if !found {
+			continue
+		}
+
+		for _, s := range m.Schedulers {
+			l := append(labelValues[:len(labelValues):len(labelValues)], ifName, strings.TrimSpace(s.ForwardingClass))
+
+			if rate, ok := parseShapingRate(s.ShapingRate.Rate); ok {
+				ch <- prometheus.MustNewConstMetric(shapingRateDesc, prometheus.GaugeValue, rate, l...)
+			}
+
+			if percent, err := strconv.ParseFloat(strings.TrimSpace(s.ShapingRate.Percent), 64); err == nil {
+				ch <- prometheus.MustNewConstMetric(shapingRatePercentDesc, prometheus.GaugeValue, percent, l...)
+			}
+		}
+	}
+
+	return nil
+}
+
+func addSchedulerMap(schedulerMaps map[string]string, ifName string, objects []cosObject) {
+	for _, o := range objects {
+		if strings.EqualFold(strings.TrimSpace(o.Type), "scheduler-map") {
+			schedulerMaps[strings.TrimSpace(ifName)] = strings.TrimSpace(o.Name)
+		}
+	}
+}
+
+// parseShapingRate parses the shaping rate of a scheduler given in bits per second (e.g. 10000000 or 10Mbps), schedulers without shaping rate report none
+func parseShapingRate(s string) (float64, bool) {
+	v := strings.TrimSpace(s)
+	if v == "" || strings.EqualFold(v, "none") {
+		return 0, false
+	}
+
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return f, true
+	}
+
+	f, err := parse.Bandwidth(strings.ReplaceAll(v, " ", ""))
+	return f, err == nil
+}
+
+func collectBindings(ch chan<- prometheus.Metric, ifName string, objects []cosObject, labelValues []string) {
+	for _, o := range objects {
+		l := append(labelValues[:len(labelValues):len(labelValues)],
+			strings.TrimSpace(ifName),
+			strings.ToLower(strings.TrimSpace(o.Type)),
+			strings.TrimSpace(o.Subtype),
+			strings.TrimSpace(o.Name))
+		ch <- prometheus.MustNewConstMetric(bindingInfoDesc, prometheus.GaugeValue, 1, l...)
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+
+package cos
+
+type result struct {
+	Information struct {
+		Interfaces []interfaceMap `xml:"interface-map"`
+	} `xml:"cos-interface-information"`
+}
+
+type interfaceMap struct {
+	Name              string       `xml:"interface-name"`
+	Objects           []cosObject  `xml:"cos-objects"`
+	LogicalInterfaces []logicalMap `xml:"i-logical-map"`
+}
+
+type logicalMap struct {
+	Name    string      `xml:"i-logical-name"`
+	Objects []cosObject `xml:"cos-objects"`
+}
+
+type cosObject struct {
+	Type    string `xml:"cos-object-type"`
+	Subtype string `xml:"cos-object-subtype"`
+	Name    string `xml:"cos-object-name"`
+}
+
+type schedulerMapResult struct {
+	Information struct {
+		SchedulerMaps []schedulerMap `xml:"scheduler-map"`
+	} `xml:"cos-scheduler-map-information"`
+}
+
+type schedulerMap struct {
+	Name       string      `xml:"scheduler-map-name"`
+	Schedulers []scheduler `xml:"scheduler"`
+}
+
+type scheduler struct {
+	Name            string `xml:"scheduler-name"`
+	ForwardingClass string `xml:"scheduler-forwarding-class-name"`
+	ShapingRate     struct {
+		Rate    string `xml:"shaping-rate"`
+		Percent string `xml:"shaping-rate-percent"`
+	} `xml:"scheduler-shaping-rate"`
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+
+package cos
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCoSInterfaces(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<cos-interface-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-cos">
+    <interface-map>
+        <interface-name>ge-0/0/0</interface-name>
+        <interface-index>150</interface-index>
+        <interface-queues-supported>8</interface-queues-supported>
+        <interface-queues-in-use>4</interface-queues-in-use>
+        <cos-objects>
+            <cos-object-type>Scheduler-map</cos-object-type>
+            <cos-object-name>sm-core</cos-object-name>
+            <cos-object-index>4</cos-object-index>
+        </cos-objects>
+        <i-logical-map>
+            <i-logical-name>ge-0/0/0.0</i-logical-name>
+            <i-logical-index>340</i-logical-index>
+            <cos-objects>
+                <cos-object-type>Classifier</cos-object-type>
+                <cos-object-subtype>dscp</cos-object-subtype>
+                <cos-object-name>cl-core</cos-object-name>
+                <cos-object-index>9</cos-object-index>
+            </cos-objects>
+            <cos-objects>
+                <cos-object-type>Rewrite</cos-object-type>
+                <cos-object-subtype>dscp</cos-object-subtype>
+                <cos-object-name>rw-core</cos-object-name>
+                <cos-object-index>10</cos-object-index>
+            </cos-objects>
+        </i-logical-map>
+    </interface-map>
+</cos-interface-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.Interfaces), "interfaces")
+
+	iface := x.Information.Interfaces[0]
+	assert.Equal(t, "ge-0/0/0", iface.Name, "name")
+	assert.Equal(t, []cosObject{{Type: "Scheduler-map", Name: "sm-core"}}, iface.Objects, "physical interface objects")
+
+	assert.Equal(t, 1, len(iface.LogicalInterfaces), "logical interfaces")
+	logical := iface.LogicalInterfaces[0]
+	assert.Equal(t, "ge-0/0/0.0", logical.Name, "logical name")
+	assert.Equal(t, []cosObject{
+		{Type: "Classifier", Subtype: "dscp", Name: "cl-core"},
+		{Type: "Rewrite", Subtype: "dscp", Name: "rw-core"},
+	}, logical.Objects, "logical interface objects")
+}
+
+func TestParseSchedulerMaps(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<cos-scheduler-map-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-cos">
+    <scheduler-map>
+        <scheduler-map-name>sm-core</scheduler-map-name>
+        <scheduler-map-index>4</scheduler-map-index>
+        <scheduler>
+            <scheduler-name>sc-be</scheduler-name>
+            <scheduler-index>12</scheduler-index>
+            <scheduler-forwarding-class-name>best-effort</scheduler-forwarding-class-name>
+            <scheduler-shaping-rate>
+                <shaping-rate>none</shaping-rate>
+            </scheduler-shaping-rate>
+        </scheduler>
+        <scheduler>
+            <scheduler-name>sc-ef</scheduler-name>
+            <scheduler-index>13</scheduler-index>
+            <scheduler-forwarding-class-name>expedited-forwarding</scheduler-forwarding-class-name>
+            <scheduler-shaping-rate>
+                <shaping-rate>100000000</shaping-rate>
+            </scheduler-shaping-rate>
+        </scheduler>
+        <scheduler>
+            <scheduler-name>sc-af</scheduler-name>
+            <scheduler-index>14</scheduler-index>
+            <scheduler-forwarding-class-name>assured-forwarding</scheduler-forwarding-class-name>
+            <scheduler-shaping-rate>
+                <shaping-rate-percent>20</shaping-rate-percent>
+            </scheduler-shaping-rate>
+        </scheduler>
+    </scheduler-map>
+</cos-scheduler-map-information>
+</rpc-reply>`
+
+	var x schedulerMapResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.SchedulerMaps), "scheduler maps")
+
+	m := x.Information.SchedulerMaps[0]
+	assert.Equal(t, "sm-core", m.Name, "name")
+	assert.Equal(t, 3, len(m.Schedulers), "schedulers")
+	assert.Equal(t, "expedited-forwarding", m.Schedulers[1].ForwardingClass, "forwarding class")
+
+	_, ok := parseShapingRate(m.Schedulers[0].ShapingRate.Rate)
+	assert.False(t, ok, "no shaping rate")
+
+	rate, ok := parseShapingRate(m.Schedulers[1].ShapingRate.Rate)
+	assert.True(t, ok, "shaping rate")
+	assert.Equal(t, 100e6, rate, "shaping rate")
+
+	assert.Equal(t, "20", m.Schedulers[2].ShapingRate.Percent, "shaping rate percent")
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+
+package ddosprotection
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix = "junos_ddos_protection_"
+
+var (
+	receivedDesc       *prometheus.Desc
+	droppedDesc        *prometheus.Desc
+	arrivalRateDesc    *prometheus.Desc
+	maxArrivalRateDesc *prometheus.Desc
+	violationDesc      *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "group", "packet_type"}
+	receivedDesc = prometheus.NewDesc(prefix+"received_packets_total", "Number of packets received by the protocol (system-wide)", l, nil)
+	droppedDesc = prometheus.NewDesc(prefix+"dropped_packets_total", "Number of packets dropped by the protocol policer (system-wide)", l, nil)
+	arrivalRateDesc = prometheus.NewDesc(prefix+"arrival_rate_pps", "Current arrival rate of the protocol in packets per second", l, nil)
+	maxArrivalRateDesc = prometheus.NewDesc(prefix+"max_arrival_rate_pps", "Maximum arrival rate of the protocol in packets per second", l, nil)
+	violationDesc = prometheus.NewDesc(prefix+"violation", "Policer bandwidth of the protocol is currently violated (1 = violated)", l, nil)
+}
+
+type ddosProtectionCollector struct {
+}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &ddosProtectionCollector{}
+}
+
+// Name returns the name of the collector
+func (*ddosProtectionCollector) Name() string {
+	return "DDoS Protection"
+}
+
+// Describe describes the metrics
+func (*ddosProtectionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- receivedDesc
+	ch <- droppedDesc
+	ch <- arrivalRateDesc
+	ch <- maxArrivalRateDesc
+	ch <- violationDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *ddosProtectionCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show ddos-protection protocols statistics", &x)
+	if err != nil {
+		return err
+	}
+
+	var v = result{}
+	err = client.RunCommandAndParse("show ddos-protection protocols violations", &v)
+	if err != nil {
+		return err
+	}
+
+	violated := violations(&v)
+	for _, g := range x.Information.Groups {
+		for _, p := range g.Protocols {
+			l := append(labelValues[:len(labelValues):len(labelValues)], g.Name, p.PacketType)
+
+			ch <- prometheus.MustNewConstMetric(receivedDesc, prometheus.CounterValue, p.Statistics.Received, l...)
+			ch <- prometheus.MustNewConstMetric(droppedDesc, prometheus.CounterValue, p.Statistics.Dropped, l...)
+			ch <- prometheus.MustNewConstMetric(arrivalRateDesc, prometheus.GaugeValue, p.Statistics.ArrivalRate, l...)
+			ch <- prometheus.MustNewConstMetric(maxArrivalRateDesc, prometheus.GaugeValue, p.Statistics.MaxArrivalRate, l...)
+
+			state := 0.0
+			if violated[violationKey(g.Name, p.PacketType)] {
+				state = 1
+			}
+			ch <- prometheus.MustNewConstMetric(violationDesc, prometheus.GaugeValue, state, l...)
+		}
+	}
+
+	return nil
+}
+
+// violations returns the protocols listed in the output of show ddos-protection protocols violations
+func violations(x *result) map[string]bool {
+	m := make(map[string]bool)
+	for _, g := range x.Information.Groups {
+		for _, p := range g.Protocols {
+			m[violationKey(g.Name, p.PacketType)] = true
+		}
+	}
+
+	return m
+}
+
+func violationKey(group, packetType string) string {
+	return group + "/" + packetType
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+
+package ddosprotection
+
+type result struct {
+	Information struct {
+		Groups []protocolGroup `xml:"ddos-protocol-group"`
+	} `xml:"ddos-protocols-information"`
+}
+
+type protocolGroup struct {
+	Name      string     `xml:"group-name"`
+	Protocols []protocol `xml:"ddos-protocol"`
+}
+
+type protocol struct {
+	PacketType string `xml:"packet-type"`
+	Statistics struct {
+		Received       float64 `xml:"packet-received"`
+		ArrivalRate    float64 `xml:"packet-arrival-rate"`
+		Dropped        float64 `xml:"packet-dropped"`
+		MaxArrivalRate float64 `xml:"packet-arrival-rate-max"`
+	} `xml:"ddos-system-statistics"`
+}
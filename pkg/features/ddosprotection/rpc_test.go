@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+
+package ddosprotection
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatistics(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R0/junos">
+    <ddos-protocols-information xmlns="http://xml.juniper.net/junos/21.2R0/junos-jddosd" junos:style="statistics">
+        <total-packet-types>250</total-packet-types>
+        <packet-types-in-violation>1</packet-types-in-violation>
+        <ddos-protocol-group>
+            <group-name>ARP</group-name>
+            <ddos-protocol>
+                <packet-type>aggregate</packet-type>
+                <ddos-system-statistics junos:style="clear">
+                    <packet-received>13580</packet-received>
+                    <packet-arrival-rate>12</packet-arrival-rate>
+                    <packet-dropped>250</packet-dropped>
+                    <packet-arrival-rate-max>4500</packet-arrival-rate-max>
+                </ddos-system-statistics>
+            </ddos-protocol>
+        </ddos-protocol-group>
+        <ddos-protocol-group>
+            <group-name>ICMP</group-name>
+            <ddos-protocol>
+                <packet-type>aggregate</packet-type>
+                <ddos-system-statistics junos:style="clear">
+                    <packet-received>42</packet-received>
+                    <packet-arrival-rate>0</packet-arrival-rate>
+                    <packet-dropped>0</packet-dropped>
+                    <packet-arrival-rate-max>3</packet-arrival-rate-max>
+                </ddos-system-statistics>
+            </ddos-protocol>
+        </ddos-protocol-group>
+    </ddos-protocols-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Len(t, x.Information.Groups, 2)
+	arp := x.Information.Groups[0]
+	assert.Equal(t, "ARP", arp.Name)
+	assert.Equal(t, "aggregate", arp.Protocols[0].PacketType)
+	assert.Equal(t, float64(13580), arp.Protocols[0].Statistics.Received)
+	assert.Equal(t, float64(12), arp.Protocols[0].Statistics.ArrivalRate)
+	assert.Equal(t, float64(250), arp.Protocols[0].Statistics.Dropped)
+	assert.Equal(t, float64(4500), arp.Protocols[0].Statistics.MaxArrivalRate)
+}
+
+func TestParseViolations(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R0/junos">
+    <ddos-protocols-information xmlns="http://xml.juniper.net/junos/21.2R0/junos-jddosd" junos:style="violations">
+        <packet-types-in-violation>1</packet-types-in-violation>
+        <ddos-protocol-group>
+            <group-name>ARP</group-name>
+            <ddos-protocol>
+                <packet-type>aggregate</packet-type>
+            </ddos-protocol>
+        </ddos-protocol-group>
+    </ddos-protocols-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	v := violations(&x)
+	assert.True(t, v[violationKey("ARP", "aggregate")])
+	assert.False(t, v[violationKey("ICMP", "aggregate")])
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+
+package dhcp
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+const prefix string = "junos_dhcp_"
+
+var (
+	relayMessagesReceivedDesc *prometheus.Desc
+	relayMessagesSentDesc     *prometheus.Desc
+	relayPacketsDroppedDesc   *prometheus.Desc
+	relayDroppedReasonDesc    *prometheus.Desc
+	bindingsActiveDesc        *prometheus.Desc
+	bindingsDesc              *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "instance", "message_type"}
+	relayMessagesReceivedDesc = prometheus.NewDesc(prefix+"relay_messages_received_total", "Number of DHCP messages received by the relay", l, nil)
+	relayMessagesSentDesc = prometheus.NewDesc(prefix+"relay_messages_sent_total", "Number of DHCP messages sent by the relay", l, nil)
+
+	l = []string{"target", "instance"}
+	relayPacketsDroppedDesc = prometheus.NewDesc(prefix+"relay_packets_dropped_total", "Number of DHCP packets discarded by the relay", l, nil)
+	bindingsActiveDesc = prometheus.NewDesc(prefix+"server_bindings_active_count", "Number of bound DHCP server bindings (active leases)", l, nil)
+
+	l = []string{"target", "instance", "reason"}
+	relayDroppedReasonDesc = prometheus.NewDesc(prefix+"relay_packets_dropped_reason_total", "Number of DHCP packets discarded by the relay by reason (e.g. bad packets)", l, nil)
+
+	l = []string{"target", "instance", "state"}
+	bindingsDesc = prometheus.NewDesc(prefix+"server_bindings_count", "Number of DHCP server bindings by state", l, nil)
+}
+
+type dhcpCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &dhcpCollector{}
+}
+
+// Name returns the name of the collector
+func (*dhcpCollector) Name() string {
+	return "DHCP"
+}
+
+// Describe describes the metrics
+func (*dhcpCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- relayMessagesReceivedDesc
+	ch <- relayMessagesSentDesc
+	ch <- relayPacketsDroppedDesc
+	ch <- relayDroppedReasonDesc
+	ch <- bindingsActiveDesc
+	ch <- bindingsDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *dhcpCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var r = relayStatisticsResult{}
+	err := client.RunCommandAndParse("show dhcp relay statistics routing-instance all", &r)
+	if err != nil {
+		return err
+	}
+
+	c.collectRelayStatistics(&r, ch, labelValues)
+
+	// devices only relaying DHCP do not run a local server, the relay statistics are exported anyway
+	var b = serverBindingResult{}
+	err = client.RunCommandAndParse("show dhcp server binding routing-instance all", &b)
+	if err != nil {
+		log.Debugf("could not retrieve DHCP server bindings from %s: %v", client.Device().Host, err)
+		return nil
+	}
+
+	c.collectBindings(&b, ch, labelValues)
+
+	return nil
+}
+
+func (c *dhcpCollector) collectRelayStatistics(x *relayStatisticsResult, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, s := range x.Information {
+		l := append(labelValues[:len(labelValues):len(labelValues)], routingInstanceName(s.RoutingInstance))
+		ch <- prometheus.MustNewConstMetric(relayPacketsDroppedDesc, prometheus.CounterValue, float64(s.Dropped.Total), l...)
+
+		for _, r := range s.Dropped.Reasons {
+			rl := append(l[:len(l):len(l)], r.Reason)
+			ch <- prometheus.MustNewConstMetric(relayDroppedReasonDesc, prometheus.CounterValue, float64(r.Count), rl...)
+		}
+
+		for _, m := range s.Received {
+			ml := append(l[:len(l):len(l)], m.Type)
+			ch <- prometheus.MustNewConstMetric(relayMessagesReceivedDesc, prometheus.CounterValue, float64(m.Count), ml...)
+		}
+
+		for _, m := range s.Sent {
+			ml := append(l[:len(l):len(l)], m.Type)
+			ch <- prometheus.MustNewConstMetric(relayMessagesSentDesc, prometheus.CounterValue, float64(m.Count), ml...)
+		}
+	}
+}
+
+func (c *dhcpCollector) collectBindings(x *serverBindingResult, ch chan<- prometheus.Metric, labelValues []string) {
+	states := make(map[string]map[string]int)
+	for _, b := range x.Information.Bindings {
+		ri := routingInstanceName(b.RoutingInstance)
+		if states[ri] == nil {
+			states[ri] = make(map[string]int)
+		}
+
+		states[ri][strings.ToLower(strings.TrimSpace(b.State))]++
+	}
+
+	for ri, counts := range states {
+		l := append(labelValues[:len(labelValues):len(labelValues)], ri)
+		ch <- prometheus.MustNewConstMetric(bindingsActiveDesc, prometheus.GaugeValue, float64(counts["bound"]), l...)
+
+		for state, count := range counts {
+			sl := append(l[:len(l):len(l)], state)
+			ch <- prometheus.MustNewConstMetric(bindingsDesc, prometheus.GaugeValue, float64(count), sl...)
+		}
+	}
+}
+
+// routingInstanceName returns the name of the routing instance, the default instance is named master (as in the multicast collector)
+func routingInstanceName(name string) string {
+	if name == "" || name == "default" {
+		return "master"
+	}
+
+	return name
+}
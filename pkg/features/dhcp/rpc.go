@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+
+package dhcp
+
+type relayStatisticsResult struct {
+	Information []relayStatistics `xml:"dhcp-relay-statistics-information"`
+}
+
+type relayStatistics struct {
+	RoutingInstance string `xml:"routing-instance-name"`
+	Dropped         struct {
+		Total   uint64          `xml:"dhcp-packets-dropped-total"`
+		Reasons []droppedReason `xml:"dhcp-packets-dropped-entry"`
+	} `xml:"dhcp-packets-dropped"`
+	Received []messageCount `xml:"dhcp-messages-received>dhcp-message-entry"`
+	Sent     []messageCount `xml:"dhcp-messages-sent>dhcp-message-entry"`
+}
+
+type droppedReason struct {
+	Reason string `xml:"dhcp-packets-dropped-reason"`
+	Count  uint64 `xml:"dhcp-packets-dropped-count"`
+}
+
+type messageCount struct {
+	Type  string `xml:"dhcp-message-type"`
+	Count uint64 `xml:"dhcp-message-count"`
+}
+
+type serverBindingResult struct {
+	Information struct {
+		Bindings []serverBinding `xml:"dhcp-binding"`
+	} `xml:"dhcp-server-binding-information"`
+}
+
+type serverBinding struct {
+	Address         string `xml:"allocated-address"`
+	MACAddress      string `xml:"mac-address"`
+	State           string `xml:"binding-state"`
+	Interface       string `xml:"interface-name"`
+	RoutingInstance string `xml:"routing-instance-name"`
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MIT
+
+package dhcp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelayStatistics(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<dhcp-relay-statistics-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-jdhcp">
+    <routing-instance-name>default</routing-instance-name>
+    <dhcp-packets-dropped>
+        <dhcp-packets-dropped-total>7</dhcp-packets-dropped-total>
+        <dhcp-packets-dropped-entry>
+            <dhcp-packets-dropped-reason>Bad hardware address</dhcp-packets-dropped-reason>
+            <dhcp-packets-dropped-count>3</dhcp-packets-dropped-count>
+        </dhcp-packets-dropped-entry>
+        <dhcp-packets-dropped-entry>
+            <dhcp-packets-dropped-reason>No binding found</dhcp-packets-dropped-reason>
+            <dhcp-packets-dropped-count>4</dhcp-packets-dropped-count>
+        </dhcp-packets-dropped-entry>
+    </dhcp-packets-dropped>
+    <dhcp-messages-received>
+        <dhcp-message-entry>
+            <dhcp-message-type>BOOTREQUEST</dhcp-message-type>
+            <dhcp-message-count>1200</dhcp-message-count>
+        </dhcp-message-entry>
+        <dhcp-message-entry>
+            <dhcp-message-type>DHCPDISCOVER</dhcp-message-type>
+            <dhcp-message-count>300</dhcp-message-count>
+        </dhcp-message-entry>
+    </dhcp-messages-received>
+    <dhcp-messages-sent>
+        <dhcp-message-entry>
+            <dhcp-message-type>BOOTREPLY</dhcp-message-type>
+            <dhcp-message-count>1100</dhcp-message-count>
+        </dhcp-message-entry>
+    </dhcp-messages-sent>
+</dhcp-relay-statistics-information>
+<dhcp-relay-statistics-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-jdhcp">
+    <routing-instance-name>CUSTOMER</routing-instance-name>
+    <dhcp-packets-dropped>
+        <dhcp-packets-dropped-total>0</dhcp-packets-dropped-total>
+    </dhcp-packets-dropped>
+</dhcp-relay-statistics-information>
+</rpc-reply>`
+
+	var x relayStatisticsResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information), "instance count")
+
+	s := x.Information[0]
+	assert.Equal(t, "default", s.RoutingInstance, "routing-instance-name")
+	assert.Equal(t, uint64(7), s.Dropped.Total, "dhcp-packets-dropped-total")
+	assert.Equal(t, 2, len(s.Dropped.Reasons), "reason count")
+	assert.Equal(t, "Bad hardware address", s.Dropped.Reasons[0].Reason, "dhcp-packets-dropped-reason")
+	assert.Equal(t, uint64(3), s.Dropped.Reasons[0].Count, "dhcp-packets-dropped-count")
+	assert.Equal(t, 2, len(s.Received), "received count")
+	assert.Equal(t, "DHCPDISCOVER", s.Received[1].Type, "dhcp-message-type")
+	assert.Equal(t, uint64(300), s.Received[1].Count, "dhcp-message-count")
+	assert.Equal(t, 1, len(s.Sent), "sent count")
+	assert.Equal(t, uint64(1100), s.Sent[0].Count, "dhcp-message-count")
+
+	assert.Equal(t, "CUSTOMER", x.Information[1].RoutingInstance, "routing-instance-name")
+	assert.Equal(t, 0, len(x.Information[1].Received), "received count")
+}
+
+func TestParseServerBindings(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<dhcp-server-binding-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-jdhcp">
+    <dhcp-binding>
+        <allocated-address>192.0.2.10</allocated-address>
+        <mac-address>00:11:22:33:44:55</mac-address>
+        <binding-state>BOUND</binding-state>
+        <interface-name>ge-0/0/1.100</interface-name>
+        <routing-instance-name>CUSTOMER</routing-instance-name>
+    </dhcp-binding>
+    <dhcp-binding>
+        <allocated-address>192.0.2.11</allocated-address>
+        <mac-address>00:11:22:33:44:56</mac-address>
+        <binding-state>SELECTING</binding-state>
+        <interface-name>ge-0/0/1.100</interface-name>
+    </dhcp-binding>
+</dhcp-server-binding-information>
+</rpc-reply>`
+
+	var x serverBindingResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Bindings), "binding count")
+
+	b := x.Information.Bindings[0]
+	assert.Equal(t, "192.0.2.10", b.Address, "allocated-address")
+	assert.Equal(t, "00:11:22:33:44:55", b.MACAddress, "mac-address")
+	assert.Equal(t, "BOUND", b.State, "binding-state")
+	assert.Equal(t, "ge-0/0/1.100", b.Interface, "interface-name")
+	assert.Equal(t, "CUSTOMER", b.RoutingInstance, "routing-instance-name")
+	assert.Equal(t, "", x.Information.Bindings[1].RoutingInstance, "routing-instance-name")
+}
+
+func TestRoutingInstanceName(t *testing.T) {
+	assert.Equal(t, "master", routingInstanceName(""))
+	assert.Equal(t, "master", routingInstanceName("default"))
+	assert.Equal(t, "vrf1", routingInstanceName("vrf1"))
+}
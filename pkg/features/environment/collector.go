@@ -22,6 +22,7 @@ var (
 	fanAirflowDesc   *prometheus.Desc
 	pemDesc          *prometheus.Desc
 	fanDesc          *prometheus.Desc
+	fanRPMDesc       *prometheus.Desc
 	dcVoltageDesc    *prometheus.Desc
 	dcCurrentDesc    *prometheus.Desc
 	dcPowerDesc      *prometheus.Desc
@@ -41,6 +42,8 @@ func init() {
 	dcPowerDesc = prometheus.NewDesc(prefix+"pem_power_usage", "PEM power usage in W", l, nil)
 	dcLoadDesc = prometheus.NewDesc(prefix+"pem_power_load_percent", "PEM power usage percent of total", l, nil)
 
+	fanRPMDesc = prometheus.NewDesc(prefix+"fan_rpm", "Fan speed in RPM", l, nil)
+
 	l = []string{"target", "re_name", "item", "fan_name"}
 	fanDesc = prometheus.NewDesc(prefix+"pem_fanspeed", "Fan speed in RPM", l, nil)
 }
@@ -61,14 +64,23 @@ func (*environmentCollector) Name() string {
 // Describe describes the metrics
 func (*environmentCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- temperaturesDesc
+	ch <- powerSupplyDesc
+	ch <- fanStatusDesc
+	ch <- fanAirflowDesc
+	ch <- pemDesc
 	ch <- fanDesc
+	ch <- fanRPMDesc
+	ch <- dcVoltageDesc
+	ch <- dcCurrentDesc
 	ch <- dcPowerDesc
+	ch <- dcLoadDesc
 }
 
 // Collect collects metrics from JunOS
 func (c *environmentCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	c.environmentItems(client, ch, labelValues)
 	c.environmentPEMItems(client, ch, labelValues)
+	c.environmentFanItems(client, ch, labelValues)
 
 	return nil
 }
@@ -114,6 +126,11 @@ func (c *environmentCollector) environmentItems(client collector.Client, ch chan
 		l := labelValues
 		for _, item := range re.EnvironmentInformation.Items {
 			l = append(labelValues, re.Name)
+			if item.Status == "Absent" {
+				// empty slot (e.g. no PSU installed)
+				continue
+			}
+
 			if strings.Contains(item.Name, "Power Supply") || strings.Contains(item.Name, "PEM") {
 				l = append(l, item.Name, item.Status)
 				ch <- prometheus.MustNewConstMetric(powerSupplyDesc, prometheus.GaugeValue, float64(statusValues[item.Status]), l...)
@@ -186,6 +203,35 @@ func (c *environmentCollector) environmentPEMItems(client collector.Client, ch c
 	return nil
 }
 
+func (c *environmentCollector) environmentFanItems(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = multiEngineResult{}
+
+	err := client.RunCommandAndParseWithParser("show chassis fan", func(b []byte) error {
+		return parseXML(b, &x)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, re := range x.Results.RoutingEngines {
+		for _, f := range re.FanInformation.Items {
+			if f.Status == "Absent" {
+				continue
+			}
+
+			rpms, err := strconv.ParseFloat(strings.TrimSpace(f.RPM), 64)
+			if err != nil {
+				continue
+			}
+
+			l := append(labelValues, re.Name, f.Name)
+			ch <- prometheus.MustNewConstMetric(fanRPMDesc, prometheus.GaugeValue, rpms, l...)
+		}
+	}
+
+	return nil
+}
+
 func parseXML(b []byte, res *multiEngineResult) error {
 	if strings.Contains(string(b), "multi-routing-engine-results") {
 		return xml.Unmarshal(b, res)
@@ -203,6 +249,7 @@ func parseXML(b []byte, res *multiEngineResult) error {
 			Name:                            "N/A",
 			EnvironmentComponentInformation: fi.EnvironmentComponentInformation,
 			EnvironmentInformation:          fi.EnvironmentInformation,
+			FanInformation:                  fi.FanInformation,
 		},
 	}
 	return nil
@@ -17,6 +17,7 @@ type routingEngine struct {
 	Name                            string                          `xml:"re-name"`
 	EnvironmentComponentInformation environmentComponentInformation `xml:"environment-component-information"`
 	EnvironmentInformation          environmentInformation          `xml:"environment-information"`
+	FanInformation                  fanInformation                  `xml:"fan-information"`
 }
 
 type environmentComponentInformation struct {
@@ -54,8 +55,19 @@ type environmentItem struct {
 	} `xml:"temperature,omitempty"`
 }
 
+type fanInformation struct {
+	Items []fanItem `xml:"fan-information-rpm-item"`
+}
+
+type fanItem struct {
+	Name   string `xml:"name"`
+	Status string `xml:"status"`
+	RPM    string `xml:"rpm"`
+}
+
 type singleEngineResult struct {
 	XMLName                         xml.Name                        `xml:"rpc-reply"`
 	EnvironmentComponentInformation environmentComponentInformation `xml:"environment-component-information"`
 	EnvironmentInformation          environmentInformation          `xml:"environment-information"`
+	FanInformation                  fanInformation                  `xml:"fan-information"`
 }
@@ -621,3 +621,42 @@ func TestParseNoMultiREOutputEX(t *testing.T) {
 	assert.Equal(t, "FPC 1 Fan 1 Airflow", f.Name, "name")
 	assert.Equal(t, "OK", f.Status, "status")
 }
+
+func TestParseNoMultiREOutputMXFan(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/17XXX/junos">
+    <fan-information xmlns="http://xml.juniper.net/junos/17XXX/junos-chassis">
+        <fan-information-rpm-item>
+            <name>Fan Tray 0 Fan 1</name>
+            <status>OK</status>
+            <rpm>5280</rpm>
+            <comment>Spinning at normal speed</comment>
+        </fan-information-rpm-item>
+        <fan-information-rpm-item>
+            <name>Fan Tray 1 Fan 1</name>
+            <status>Absent</status>
+        </fan-information-rpm-item>
+    </fan-information>
+    <cli>
+        <banner>{master}</banner>
+    </cli>
+</rpc-reply>`
+
+	rpc := multiEngineResult{}
+	err := parseXML([]byte(body), &rpc)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(rpc.Results.RoutingEngines[0].FanInformation.Items), "fans")
+
+	f := rpc.Results.RoutingEngines[0].FanInformation.Items[0]
+
+	assert.Equal(t, "Fan Tray 0 Fan 1", f.Name, "name")
+	assert.Equal(t, "OK", f.Status, "status")
+	assert.Equal(t, "5280", f.RPM, "rpm")
+
+	f = rpc.Results.RoutingEngines[0].FanInformation.Items[1]
+
+	assert.Equal(t, "Absent", f.Status, "status")
+}
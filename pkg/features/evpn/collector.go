@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+
+package evpn
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_evpn_"
+
+var (
+	irbInterfaceUpDesc *prometheus.Desc
+	remotePEsDesc      *prometheus.Desc
+	macCountDesc       *prometheus.Desc
+	macMovesDesc       *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "instance", "interface"}
+	irbInterfaceUpDesc = prometheus.NewDesc(prefix+"irb_interface_up", "IRB interface of the EVPN instance is up (1 = up)", l, nil)
+
+	l = []string{"target", "instance"}
+	remotePEsDesc = prometheus.NewDesc(prefix+"remote_pe_count", "Number of remote PEs (neighbors) of the EVPN instance", l, nil)
+
+	l = []string{"target", "instance", "vni"}
+	macCountDesc = prometheus.NewDesc(prefix+"mac_count", "Number of MAC addresses in the EVPN database", l, nil)
+	macMovesDesc = prometheus.NewDesc(prefix+"mac_moves", "MAC moves of the MACs currently in the EVPN database (sum of the MAC mobility sequence numbers, drops when MACs age out)", l, nil)
+}
+
+type evpnCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &evpnCollector{}
+}
+
+// Name returns the name of the collector
+func (*evpnCollector) Name() string {
+	return "EVPN"
+}
+
+// Describe describes the metrics
+func (*evpnCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- irbInterfaceUpDesc
+	ch <- remotePEsDesc
+	ch <- macCountDesc
+	ch <- macMovesDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *evpnCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var i = instanceResult{}
+	err := client.RunCommandAndParse("show evpn instance extensive", &i)
+	if err != nil {
+		return err
+	}
+
+	collector.ReportRecords(client, len(i.Information.Instances))
+	c.collectInstances(&i, ch, labelValues)
+
+	var d = databaseResult{}
+	err = client.RunCommandAndParse("show evpn database extensive", &d)
+	if err != nil {
+		return err
+	}
+
+	for _, inst := range d.Information.Instances {
+		collector.ReportRecords(client, len(inst.Entries))
+	}
+	c.collectDatabase(&d, ch, labelValues)
+
+	return nil
+}
+
+func (c *evpnCollector) collectInstances(x *instanceResult, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, inst := range x.Information.Instances {
+		l := append(labelValues[:len(labelValues):len(labelValues)], inst.Name)
+		ch <- prometheus.MustNewConstMetric(remotePEsDesc, prometheus.GaugeValue, float64(len(inst.Neighbors)), l...)
+
+		for _, irb := range inst.IRBInterfaces {
+			up := 0
+			if strings.EqualFold(strings.TrimSpace(irb.Status), "up") {
+				up = 1
+			}
+
+			il := append(l[:len(l):len(l)], irb.Name)
+			ch <- prometheus.MustNewConstMetric(irbInterfaceUpDesc, prometheus.GaugeValue, float64(up), il...)
+		}
+	}
+}
+
+func (c *evpnCollector) collectDatabase(x *databaseResult, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, inst := range x.Information.Instances {
+		counts := make(map[string]int)
+		moves := make(map[string]uint64)
+		for _, e := range inst.Entries {
+			counts[e.VNI]++
+			moves[e.VNI] += e.MobilitySequenceNumber
+		}
+
+		for vni, count := range counts {
+			l := append(labelValues[:len(labelValues):len(labelValues)], inst.Name, vni)
+			ch <- prometheus.MustNewConstMetric(macCountDesc, prometheus.GaugeValue, float64(count), l...)
+			ch <- prometheus.MustNewConstMetric(macMovesDesc, prometheus.GaugeValue, float64(moves[vni]), l...)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+
+package evpn
+
+type instanceResult struct {
+	Information struct {
+		Instances []instance `xml:"evpn-instance"`
+	} `xml:"evpn-instance-information"`
+}
+
+type instance struct {
+	Name          string         `xml:"evpn-instance-name"`
+	IRBInterfaces []irbInterface `xml:"irb-interface-status-table>irb-interface"`
+	Neighbors     []neighbor     `xml:"evpn-neighbor-list>evpn-neighbor"`
+}
+
+type irbInterface struct {
+	Name   string `xml:"irb-interface-name"`
+	Status string `xml:"irb-interface-status"`
+}
+
+type neighbor struct {
+	Address string `xml:"evpn-neighbor-address"`
+}
+
+type databaseResult struct {
+	Information struct {
+		Instances []databaseInstance `xml:"evpn-database-instance"`
+	} `xml:"evpn-database-information"`
+}
+
+type databaseInstance struct {
+	Name    string     `xml:"instance-name"`
+	Entries []macEntry `xml:"mac-entry"`
+}
+
+type macEntry struct {
+	VNI                    string `xml:"vni-id"`
+	MACAddress             string `xml:"mac-address"`
+	MobilitySequenceNumber uint64 `xml:"mobility-sequence-number"`
+}
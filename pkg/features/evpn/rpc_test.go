@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+
+package evpn
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstances(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<evpn-instance-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-evpn" junos:style="extensive">
+    <evpn-instance junos:style="extensive">
+        <evpn-instance-name>EVPN-VXLAN</evpn-instance-name>
+        <route-distinguisher>192.0.2.1:100</route-distinguisher>
+        <irb-interface-status-table>
+            <irb-interface>
+                <irb-interface-name>irb.100</irb-interface-name>
+                <irb-interface-status>Up</irb-interface-status>
+            </irb-interface>
+            <irb-interface>
+                <irb-interface-name>irb.200</irb-interface-name>
+                <irb-interface-status>Down</irb-interface-status>
+            </irb-interface>
+        </irb-interface-status-table>
+        <evpn-neighbor-list>
+            <evpn-neighbor>
+                <evpn-neighbor-address>192.0.2.2</evpn-neighbor-address>
+            </evpn-neighbor>
+            <evpn-neighbor>
+                <evpn-neighbor-address>192.0.2.3</evpn-neighbor-address>
+            </evpn-neighbor>
+        </evpn-neighbor-list>
+    </evpn-instance>
+    <evpn-instance junos:style="extensive">
+        <evpn-instance-name>__default_evpn__</evpn-instance-name>
+    </evpn-instance>
+</evpn-instance-information>
+</rpc-reply>`
+
+	var x instanceResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Instances), "instance count")
+
+	i := x.Information.Instances[0]
+	assert.Equal(t, "EVPN-VXLAN", i.Name, "evpn-instance-name")
+	assert.Equal(t, 2, len(i.IRBInterfaces), "irb interface count")
+	assert.Equal(t, "irb.100", i.IRBInterfaces[0].Name, "irb-interface-name")
+	assert.Equal(t, "Up", i.IRBInterfaces[0].Status, "irb-interface-status")
+	assert.Equal(t, "Down", i.IRBInterfaces[1].Status, "irb-interface-status")
+	assert.Equal(t, 2, len(i.Neighbors), "neighbor count")
+	assert.Equal(t, "192.0.2.3", i.Neighbors[1].Address, "evpn-neighbor-address")
+
+	assert.Equal(t, 0, len(x.Information.Instances[1].Neighbors), "neighbor count")
+}
+
+func TestParseDatabase(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<evpn-database-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-evpn" junos:style="extensive">
+    <evpn-database-instance>
+        <instance-name>EVPN-VXLAN</instance-name>
+        <mac-entry junos:style="extensive">
+            <vni-id>5100</vni-id>
+            <mac-address>00:00:5e:00:53:01</mac-address>
+            <mobility-sequence-number>0</mobility-sequence-number>
+        </mac-entry>
+        <mac-entry junos:style="extensive">
+            <vni-id>5100</vni-id>
+            <mac-address>00:00:5e:00:53:02</mac-address>
+            <mobility-sequence-number>3</mobility-sequence-number>
+        </mac-entry>
+        <mac-entry junos:style="extensive">
+            <vni-id>5200</vni-id>
+            <mac-address>00:00:5e:00:53:03</mac-address>
+            <mobility-sequence-number>1</mobility-sequence-number>
+        </mac-entry>
+    </evpn-database-instance>
+</evpn-database-information>
+</rpc-reply>`
+
+	var x databaseResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.Instances), "instance count")
+
+	i := x.Information.Instances[0]
+	assert.Equal(t, "EVPN-VXLAN", i.Name, "instance-name")
+	assert.Equal(t, 3, len(i.Entries), "mac entry count")
+	assert.Equal(t, "5100", i.Entries[1].VNI, "vni-id")
+	assert.Equal(t, "00:00:5e:00:53:02", i.Entries[1].MACAddress, "mac-address")
+	assert.Equal(t, uint64(3), i.Entries[1].MobilitySequenceNumber, "mobility-sequence-number")
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+
+package fabric
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_fabric_plane_"
+
+var (
+	upDesc     *prometheus.Desc
+	errorsDesc *prometheus.Desc
+	uptimeDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "plane"}
+	upDesc = prometheus.NewDesc(prefix+"up", "Status of the fabric plane (1 = Online)", append(l, "state"), nil)
+	errorsDesc = prometheus.NewDesc(prefix+"errors", "Fabric plane reports errors (1 = errors)", l, nil)
+	uptimeDesc = prometheus.NewDesc(prefix+"uptime_seconds", "Seconds since the fabric plane is online", l, nil)
+}
+
+type fabricCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &fabricCollector{}
+}
+
+// Name returns the name of the collector
+func (*fabricCollector) Name() string {
+	return "Fabric"
+}
+
+// Describe describes the metrics
+func (*fabricCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- upDesc
+	ch <- errorsDesc
+	ch <- uptimeDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *fabricCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show chassis fabric summary", &x)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range x.Information.Planes {
+		state := strings.TrimSpace(p.State)
+		l := append(labelValues[:len(labelValues):len(labelValues)], strings.TrimSpace(p.Slot))
+
+		up := 0.0
+		if state == "Online" {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, up, append(l[:len(l):len(l)], state)...)
+
+		errors := 0.0
+		if strings.EqualFold(strings.TrimSpace(p.Errors), "yes") {
+			errors = 1
+		}
+		ch <- prometheus.MustNewConstMetric(errorsDesc, prometheus.GaugeValue, errors, l...)
+
+		if p.Uptime.Seconds > 0 {
+			ch <- prometheus.MustNewConstMetric(uptimeDesc, prometheus.GaugeValue, float64(p.Uptime.Seconds), l...)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: MIT
+
+package fabric
+
+type result struct {
+	Information struct {
+		Planes []plane `xml:"fm-state-item"`
+	} `xml:"fm-state-information"`
+}
+
+type plane struct {
+	Slot   string `xml:"plane-slot"`
+	State  string `xml:"state"`
+	Errors string `xml:"errors"`
+	Uptime struct {
+		Seconds int64 `xml:"seconds,attr"`
+	} `xml:"fm-uptime"`
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+
+package fabric
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFabricSummary(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<fm-state-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-chassis">
+    <fm-state-item>
+        <plane-slot>0</plane-slot>
+        <state>Online</state>
+        <errors>NO</errors>
+        <fm-uptime junos:seconds="2945130">34 days, 2 hours, 5 minutes, 30 seconds</fm-uptime>
+    </fm-state-item>
+    <fm-state-item>
+        <plane-slot>1</plane-slot>
+        <state>Check</state>
+        <errors>YES</errors>
+        <fm-uptime junos:seconds="0"></fm-uptime>
+    </fm-state-item>
+</fm-state-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Planes), "planes")
+
+	p := x.Information.Planes[0]
+	assert.Equal(t, "0", p.Slot, "slot")
+	assert.Equal(t, "Online", p.State, "state")
+	assert.Equal(t, "NO", p.Errors, "errors")
+	assert.Equal(t, int64(2945130), p.Uptime.Seconds, "uptime")
+
+	assert.Equal(t, "Check", x.Information.Planes[1].State, "state")
+}
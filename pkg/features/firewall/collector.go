@@ -21,8 +21,8 @@ func init() {
 
 	counterPackets = prometheus.NewDesc(prefix+"counter_packets", "Number of packets matching counter in firewall filter", l, nil)
 	counterBytes = prometheus.NewDesc(prefix+"counter_bytes", "Number of bytes matching counter in firewall filter", l, nil)
-	policerPackets = prometheus.NewDesc(prefix+"policer_packets", "Number of packets matching policer in firewall filter", l, nil)
-	policerBytes = prometheus.NewDesc(prefix+"policer_bytes", "Number of bytes matching policer in firewall filter", l, nil)
+	policerPackets = prometheus.NewDesc(prefix+"policer_packets", "Number of packets discarded by policer in firewall filter", l, nil)
+	policerBytes = prometheus.NewDesc(prefix+"policer_bytes", "Number of bytes discarded by policer in firewall filter", l, nil)
 }
 
 type firewallCollector struct {
@@ -54,6 +54,7 @@ func (c *firewallCollector) Collect(client collector.Client, ch chan<- prometheu
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.Filters))
 	for _, t := range x.Information.Filters {
 		c.collectForFilter(t, ch, labelValues)
 	}
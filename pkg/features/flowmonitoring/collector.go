@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MIT
+
+package flowmonitoring
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_flow_monitoring_"
+
+var (
+	flowPacketsDesc          *prometheus.Desc
+	flowBytesDesc            *prometheus.Desc
+	activeFlowsDesc          *prometheus.Desc
+	flowsDesc                *prometheus.Desc
+	flowsExportedDesc        *prometheus.Desc
+	exportPacketsDesc        *prometheus.Desc
+	flowCreationFailuresDesc *prometheus.Desc
+	routeLookupFailuresDesc  *prometheus.Desc
+	asLookupFailuresDesc     *prometheus.Desc
+	exportFailuresDesc       *prometheus.Desc
+	memoryOverloadDesc       *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "fpc_slot"}
+	flowPacketsDesc = prometheus.NewDesc(prefix+"packets_total", "Number of packets sampled", l, nil)
+	flowBytesDesc = prometheus.NewDesc(prefix+"bytes_total", "Number of bytes sampled", l, nil)
+	activeFlowsDesc = prometheus.NewDesc(prefix+"active_flows", "Number of active flows", l, nil)
+	flowsDesc = prometheus.NewDesc(prefix+"flows_total", "Number of flows created", l, nil)
+	flowsExportedDesc = prometheus.NewDesc(prefix+"flows_exported_total", "Number of flow records exported", l, nil)
+	exportPacketsDesc = prometheus.NewDesc(prefix+"export_packets_total", "Number of export packets sent to the collectors", l, nil)
+	flowCreationFailuresDesc = prometheus.NewDesc(prefix+"flow_creation_failures_total", "Number of flows which could not be created", l, nil)
+	routeLookupFailuresDesc = prometheus.NewDesc(prefix+"route_lookup_failures_total", "Number of failed route record lookups", l, nil)
+	asLookupFailuresDesc = prometheus.NewDesc(prefix+"as_lookup_failures_total", "Number of failed AS lookups", l, nil)
+	exportFailuresDesc = prometheus.NewDesc(prefix+"export_packet_failures_total", "Number of export packets which could not be sent", l, nil)
+	memoryOverloadDesc = prometheus.NewDesc(prefix+"memory_overload", "Flow table memory is overloaded (1 = overloaded)", l, nil)
+}
+
+type flowMonitoringCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &flowMonitoringCollector{}
+}
+
+// Name returns the name of the collector
+func (*flowMonitoringCollector) Name() string {
+	return "Flow Monitoring"
+}
+
+// Describe describes the metrics
+func (*flowMonitoringCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- flowPacketsDesc
+	ch <- flowBytesDesc
+	ch <- activeFlowsDesc
+	ch <- flowsDesc
+	ch <- flowsExportedDesc
+	ch <- exportPacketsDesc
+	ch <- flowCreationFailuresDesc
+	ch <- routeLookupFailuresDesc
+	ch <- asLookupFailuresDesc
+	ch <- exportFailuresDesc
+	ch <- memoryOverloadDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *flowMonitoringCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	err := c.collectFlows(client, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
+	return c.collectErrors(client, ch, labelValues)
+}
+
+func (c *flowMonitoringCollector) collectFlows(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = flowResult{}
+	err := client.RunCommandAndParse("show services accounting flow inline-jflow", &x)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range x.Information.FPCs {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strings.TrimSpace(f.Slot))
+
+		ch <- prometheus.MustNewConstMetric(flowPacketsDesc, prometheus.CounterValue, float64(f.FlowPackets), l...)
+		ch <- prometheus.MustNewConstMetric(flowBytesDesc, prometheus.CounterValue, float64(f.FlowBytes), l...)
+		ch <- prometheus.MustNewConstMetric(activeFlowsDesc, prometheus.GaugeValue, float64(f.ActiveFlows), l...)
+		ch <- prometheus.MustNewConstMetric(flowsDesc, prometheus.CounterValue, float64(f.TotalFlows), l...)
+		ch <- prometheus.MustNewConstMetric(flowsExportedDesc, prometheus.CounterValue, float64(f.FlowsExported), l...)
+		ch <- prometheus.MustNewConstMetric(exportPacketsDesc, prometheus.CounterValue, float64(f.FlowPacketsExported), l...)
+	}
+
+	return nil
+}
+
+func (c *flowMonitoringCollector) collectErrors(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = errorResult{}
+	err := client.RunCommandAndParse("show services accounting errors inline-jflow", &x)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range x.Information.FPCs {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strings.TrimSpace(e.Slot))
+
+		ch <- prometheus.MustNewConstMetric(flowCreationFailuresDesc, prometheus.CounterValue, float64(e.FlowCreationFailures), l...)
+		ch <- prometheus.MustNewConstMetric(routeLookupFailuresDesc, prometheus.CounterValue, float64(e.RouteLookupFailures), l...)
+		ch <- prometheus.MustNewConstMetric(asLookupFailuresDesc, prometheus.CounterValue, float64(e.ASLookupFailures), l...)
+		ch <- prometheus.MustNewConstMetric(exportFailuresDesc, prometheus.CounterValue, float64(e.ExportPacketFailures), l...)
+
+		overload := 0.0
+		if strings.EqualFold(strings.TrimSpace(e.MemoryOverload), "yes") {
+			overload = 1
+		}
+		ch <- prometheus.MustNewConstMetric(memoryOverloadDesc, prometheus.GaugeValue, overload, l...)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+
+package flowmonitoring
+
+type flowResult struct {
+	Information struct {
+		FPCs []flowInformation `xml:"inline-jflow-flow-information"`
+	} `xml:"services-accounting-information"`
+}
+
+type flowInformation struct {
+	Slot                string `xml:"fpc-slot"`
+	FlowPackets         int64  `xml:"flow-packets"`
+	FlowBytes           int64  `xml:"flow-bytes"`
+	ActiveFlows         int64  `xml:"active-flows"`
+	TotalFlows          int64  `xml:"total-flows"`
+	FlowsExported       int64  `xml:"flows-exported"`
+	FlowPacketsExported int64  `xml:"flow-packets-exported"`
+}
+
+type errorResult struct {
+	Information struct {
+		FPCs []errorInformation `xml:"inline-jflow-error-information"`
+	} `xml:"services-accounting-information"`
+}
+
+type errorInformation struct {
+	Slot                 string `xml:"fpc-slot"`
+	FlowCreationFailures int64  `xml:"flow-creation-failures"`
+	RouteLookupFailures  int64  `xml:"route-record-lookup-failures"`
+	ASLookupFailures     int64  `xml:"as-lookup-failures"`
+	ExportPacketFailures int64  `xml:"export-packet-failures"`
+	MemoryOverload       string `xml:"memory-overload"`
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+
+package flowmonitoring
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFlowOutput(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R3/junos">
+    <services-accounting-information xmlns="http://xml.juniper.net/junos/21.2R3/junos-jflow">
+        <inline-jflow-flow-information>
+            <fpc-slot>0</fpc-slot>
+            <flow-packets>7033</flow-packets>
+            <flow-bytes>1014874</flow-bytes>
+            <active-flows>12</active-flows>
+            <total-flows>240</total-flows>
+            <flows-exported>228</flows-exported>
+            <flow-packets-exported>19</flow-packets-exported>
+        </inline-jflow-flow-information>
+        <inline-jflow-flow-information>
+            <fpc-slot>1</fpc-slot>
+            <flow-packets>0</flow-packets>
+            <flow-bytes>0</flow-bytes>
+            <active-flows>0</active-flows>
+            <total-flows>0</total-flows>
+            <flows-exported>0</flows-exported>
+            <flow-packets-exported>0</flow-packets-exported>
+        </inline-jflow-flow-information>
+    </services-accounting-information>
+</rpc-reply>`
+
+	x := flowResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(x.Information.FPCs))
+
+	f := x.Information.FPCs[0]
+	assert.Equal(t, "0", f.Slot, "fpc-slot")
+	assert.Equal(t, int64(7033), f.FlowPackets, "flow-packets")
+	assert.Equal(t, int64(1014874), f.FlowBytes, "flow-bytes")
+	assert.Equal(t, int64(12), f.ActiveFlows, "active-flows")
+	assert.Equal(t, int64(240), f.TotalFlows, "total-flows")
+	assert.Equal(t, int64(228), f.FlowsExported, "flows-exported")
+	assert.Equal(t, int64(19), f.FlowPacketsExported, "flow-packets-exported")
+}
+
+func TestParseErrorOutput(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R3/junos">
+    <services-accounting-information xmlns="http://xml.juniper.net/junos/21.2R3/junos-jflow">
+        <inline-jflow-error-information>
+            <fpc-slot>0</fpc-slot>
+            <flow-creation-failures>3</flow-creation-failures>
+            <route-record-lookup-failures>1</route-record-lookup-failures>
+            <as-lookup-failures>2</as-lookup-failures>
+            <export-packet-failures>5</export-packet-failures>
+            <memory-overload>No</memory-overload>
+        </inline-jflow-error-information>
+    </services-accounting-information>
+</rpc-reply>`
+
+	x := errorResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := x.Information.FPCs[0]
+	assert.Equal(t, "0", e.Slot, "fpc-slot")
+	assert.Equal(t, int64(3), e.FlowCreationFailures, "flow-creation-failures")
+	assert.Equal(t, int64(1), e.RouteLookupFailures, "route-record-lookup-failures")
+	assert.Equal(t, int64(2), e.ASLookupFailures, "as-lookup-failures")
+	assert.Equal(t, int64(5), e.ExportPacketFailures, "export-packet-failures")
+	assert.Equal(t, "No", e.MemoryOverload, "memory-overload")
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+
+package gnmi
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/telemetry"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix = "junos_gnmi_"
+
+var (
+	upDesc         *prometheus.Desc
+	lastUpdateDesc *prometheus.Desc
+	counters       []counter
+)
+
+// counter maps a leaf of /interfaces/interface/state/counters to a metric
+type counter struct {
+	leaf string
+	desc *prometheus.Desc
+}
+
+func init() {
+	l := []string{"target"}
+	upDesc = prometheus.NewDesc(prefix+"up", "Subscription to the gNMI telemetry of the device is established", l, nil)
+	lastUpdateDesc = prometheus.NewDesc(prefix+"last_update_timestamp_seconds", "Unix timestamp of the last update received via gNMI", l, nil)
+
+	l = append(l, "name")
+	for _, c := range []struct {
+		leaf string
+		name string
+		help string
+	}{
+		{"in-octets", "interface_receive_bytes_total", "Received data in bytes"},
+		{"in-unicast-pkts", "interface_receive_unicast_packets_total", "Received unicast packets"},
+		{"in-multicast-pkts", "interface_receive_multicast_packets_total", "Received multicast packets"},
+		{"in-broadcast-pkts", "interface_receive_broadcast_packets_total", "Received broadcast packets"},
+		{"in-errors", "interface_receive_errors_total", "Number of errors caused by incoming packets"},
+		{"in-discards", "interface_receive_drops_total", "Number of dropped incoming packets"},
+		{"out-octets", "interface_transmit_bytes_total", "Transmitted data in bytes"},
+		{"out-unicast-pkts", "interface_transmit_unicast_packets_total", "Transmitted unicast packets"},
+		{"out-multicast-pkts", "interface_transmit_multicast_packets_total", "Transmitted multicast packets"},
+		{"out-broadcast-pkts", "interface_transmit_broadcast_packets_total", "Transmitted broadcast packets"},
+		{"out-errors", "interface_transmit_errors_total", "Number of errors caused by outgoing packets"},
+		{"out-discards", "interface_transmit_drops_total", "Number of dropped outgoing packets"},
+	} {
+		counters = append(counters, counter{leaf: c.leaf, desc: prometheus.NewDesc(prefix+c.name, c.help+" (streamed via gNMI)", l, nil)})
+	}
+}
+
+type gnmiCollector struct {
+	cache *telemetry.Cache
+}
+
+// NewCollector creates a new collector exporting the values streamed to cache
+func NewCollector(cache *telemetry.Cache) collector.RPCCollector {
+	return &gnmiCollector{cache: cache}
+}
+
+// Name returns the name of the collector
+func (*gnmiCollector) Name() string {
+	return "gNMI"
+}
+
+// Describe describes the metrics
+func (*gnmiCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- upDesc
+	ch <- lastUpdateDesc
+	for _, c := range counters {
+		ch <- c.desc
+	}
+}
+
+// Collect collects the metrics from the cache, no commands are run on the device
+func (c *gnmiCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	s, found := c.cache.Snapshot(client.Device().Host)
+	if !found {
+		// no subscription (e.g. device matched by a host pattern)
+		return nil
+	}
+
+	up := 0.0
+	if s.Connected {
+		up = 1
+	}
+	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, up, labelValues...)
+
+	if !s.LastUpdate.IsZero() {
+		ch <- prometheus.MustNewConstMetric(lastUpdateDesc, prometheus.GaugeValue, float64(s.LastUpdate.Unix()), labelValues...)
+	}
+
+	for name, values := range s.Interfaces {
+		l := append(labelValues[:len(labelValues):len(labelValues)], name)
+		for _, cnt := range counters {
+			v, found := values[cnt.leaf]
+			if !found {
+				continue
+			}
+
+			ch <- prometheus.MustNewConstMetric(cnt.desc, prometheus.CounterValue, v, l...)
+		}
+	}
+
+	return nil
+}
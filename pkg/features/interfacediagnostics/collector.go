@@ -63,6 +63,31 @@ type interfaceDiagnosticsCollector struct {
 	rxSignalAvgOpticalPowerDesc    *prometheus.Desc
 	rxSignalAvgOpticalPowerDbmDesc *prometheus.Desc
 
+	moduleTemperatureHighAlarmDesc *prometheus.Desc
+	moduleTemperatureLowAlarmDesc  *prometheus.Desc
+	moduleTemperatureHighWarnDesc  *prometheus.Desc
+	moduleTemperatureLowWarnDesc   *prometheus.Desc
+
+	moduleVoltageHighAlarmDesc *prometheus.Desc
+	moduleVoltageLowAlarmDesc  *prometheus.Desc
+	moduleVoltageHighWarnDesc  *prometheus.Desc
+	moduleVoltageLowWarnDesc   *prometheus.Desc
+
+	laserBiasCurrentHighAlarmDesc *prometheus.Desc
+	laserBiasCurrentLowAlarmDesc  *prometheus.Desc
+	laserBiasCurrentHighWarnDesc  *prometheus.Desc
+	laserBiasCurrentLowWarnDesc   *prometheus.Desc
+
+	laserOutputPowerHighAlarmDesc *prometheus.Desc
+	laserOutputPowerLowAlarmDesc  *prometheus.Desc
+	laserOutputPowerHighWarnDesc  *prometheus.Desc
+	laserOutputPowerLowWarnDesc   *prometheus.Desc
+
+	laserRxOpticalPowerHighAlarmDesc *prometheus.Desc
+	laserRxOpticalPowerLowAlarmDesc  *prometheus.Desc
+	laserRxOpticalPowerHighWarnDesc  *prometheus.Desc
+	laserRxOpticalPowerLowWarnDesc   *prometheus.Desc
+
 	transceiverDesc *prometheus.Desc
 }
 
@@ -100,6 +125,16 @@ func (c *interfaceDiagnosticsCollector) init() {
 	c.rxSignalAvgOpticalPowerDesc = prometheus.NewDesc(prefix+"rx_signal_avg", "Receiver signal average optical power in mW", l, nil)
 	c.rxSignalAvgOpticalPowerDbmDesc = prometheus.NewDesc(prefix+"rx_signal_avg_dbm", "Receiver signal average optical power in mW", l, nil)
 
+	c.moduleTemperatureHighAlarmDesc = prometheus.NewDesc(prefix+"temp_high_alarm", "Module temperature high alarm is set (1 = on)", l, nil)
+	c.moduleTemperatureLowAlarmDesc = prometheus.NewDesc(prefix+"temp_low_alarm", "Module temperature low alarm is set (1 = on)", l, nil)
+	c.moduleTemperatureHighWarnDesc = prometheus.NewDesc(prefix+"temp_high_warn", "Module temperature high warning is set (1 = on)", l, nil)
+	c.moduleTemperatureLowWarnDesc = prometheus.NewDesc(prefix+"temp_low_warn", "Module temperature low warning is set (1 = on)", l, nil)
+
+	c.moduleVoltageHighAlarmDesc = prometheus.NewDesc(prefix+"module_voltage_high_alarm", "Module voltage high alarm is set (1 = on)", l, nil)
+	c.moduleVoltageLowAlarmDesc = prometheus.NewDesc(prefix+"module_voltage_low_alarm", "Module voltage low alarm is set (1 = on)", l, nil)
+	c.moduleVoltageHighWarnDesc = prometheus.NewDesc(prefix+"module_voltage_high_warn", "Module voltage high warning is set (1 = on)", l, nil)
+	c.moduleVoltageLowWarnDesc = prometheus.NewDesc(prefix+"module_voltage_low_warn", "Module voltage low warning is set (1 = on)", l, nil)
+
 	l = append(l, "lane")
 	c.laserBiasCurrentDesc = prometheus.NewDesc(prefix+"laser_bias", "Laser bias current in mA", l, nil)
 	c.laserBiasCurrentHighAlarmThresholdDesc = prometheus.NewDesc(prefix+"laser_bias_high_alarm_threshold", "Laser bias current high alarm threshold", l, nil)
@@ -130,6 +165,21 @@ func (c *interfaceDiagnosticsCollector) init() {
 	c.laserRxOpticalPowerHighWarnThresholdDbmDesc = prometheus.NewDesc(prefix+"laser_rx_high_warn_threshold_dbm", "Laser rx power high warn threshold_dbm in dBm", l, nil)
 	c.laserRxOpticalPowerLowWarnThresholdDbmDesc = prometheus.NewDesc(prefix+"laser_rx_low_warn_threshold_dbm", "Laser rx power low warn threshold_dbm in dBm", l, nil)
 
+	c.laserBiasCurrentHighAlarmDesc = prometheus.NewDesc(prefix+"laser_bias_high_alarm", "Laser bias current high alarm is set (1 = on)", l, nil)
+	c.laserBiasCurrentLowAlarmDesc = prometheus.NewDesc(prefix+"laser_bias_low_alarm", "Laser bias current low alarm is set (1 = on)", l, nil)
+	c.laserBiasCurrentHighWarnDesc = prometheus.NewDesc(prefix+"laser_bias_high_warn", "Laser bias current high warning is set (1 = on)", l, nil)
+	c.laserBiasCurrentLowWarnDesc = prometheus.NewDesc(prefix+"laser_bias_low_warn", "Laser bias current low warning is set (1 = on)", l, nil)
+
+	c.laserOutputPowerHighAlarmDesc = prometheus.NewDesc(prefix+"laser_output_high_alarm", "Laser output power high alarm is set (1 = on)", l, nil)
+	c.laserOutputPowerLowAlarmDesc = prometheus.NewDesc(prefix+"laser_output_low_alarm", "Laser output power low alarm is set (1 = on)", l, nil)
+	c.laserOutputPowerHighWarnDesc = prometheus.NewDesc(prefix+"laser_output_high_warn", "Laser output power high warning is set (1 = on)", l, nil)
+	c.laserOutputPowerLowWarnDesc = prometheus.NewDesc(prefix+"laser_output_low_warn", "Laser output power low warning is set (1 = on)", l, nil)
+
+	c.laserRxOpticalPowerHighAlarmDesc = prometheus.NewDesc(prefix+"laser_rx_high_alarm", "Laser rx power high alarm is set (1 = on)", l, nil)
+	c.laserRxOpticalPowerLowAlarmDesc = prometheus.NewDesc(prefix+"laser_rx_low_alarm", "Laser rx power low alarm is set (1 = on)", l, nil)
+	c.laserRxOpticalPowerHighWarnDesc = prometheus.NewDesc(prefix+"laser_rx_high_warn", "Laser rx power high warning is set (1 = on)", l, nil)
+	c.laserRxOpticalPowerLowWarnDesc = prometheus.NewDesc(prefix+"laser_rx_low_warn", "Laser rx power low warning is set (1 = on)", l, nil)
+
 	transceiver_labels := []string{"target", "name", "serial_number", "description", "speed", "fiber_type", "vendor_name", "vendor_part_number", "wavelength"}
 	c.transceiverDesc = prometheus.NewDesc("junos_interface_transceiver", "Transceiver Info", transceiver_labels, nil)
 }
@@ -177,6 +227,27 @@ func (c *interfaceDiagnosticsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.rxSignalAvgOpticalPowerDesc
 	ch <- c.rxSignalAvgOpticalPowerDbmDesc
 
+	ch <- c.moduleTemperatureHighAlarmDesc
+	ch <- c.moduleTemperatureLowAlarmDesc
+	ch <- c.moduleTemperatureHighWarnDesc
+	ch <- c.moduleTemperatureLowWarnDesc
+	ch <- c.moduleVoltageHighAlarmDesc
+	ch <- c.moduleVoltageLowAlarmDesc
+	ch <- c.moduleVoltageHighWarnDesc
+	ch <- c.moduleVoltageLowWarnDesc
+	ch <- c.laserBiasCurrentHighAlarmDesc
+	ch <- c.laserBiasCurrentLowAlarmDesc
+	ch <- c.laserBiasCurrentHighWarnDesc
+	ch <- c.laserBiasCurrentLowWarnDesc
+	ch <- c.laserOutputPowerHighAlarmDesc
+	ch <- c.laserOutputPowerLowAlarmDesc
+	ch <- c.laserOutputPowerHighWarnDesc
+	ch <- c.laserOutputPowerLowWarnDesc
+	ch <- c.laserRxOpticalPowerHighAlarmDesc
+	ch <- c.laserRxOpticalPowerLowAlarmDesc
+	ch <- c.laserRxOpticalPowerHighWarnDesc
+	ch <- c.laserRxOpticalPowerLowWarnDesc
+
 	ch <- c.transceiverDesc
 }
 
@@ -210,6 +281,10 @@ func (c *interfaceDiagnosticsCollector) Collect(client collector.Client, ch chan
 		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureLowAlarmThresholdDesc, prometheus.GaugeValue, d.ModuleTemperatureLowAlarmThreshold, l...)
 		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureHighWarnThresholdDesc, prometheus.GaugeValue, d.ModuleTemperatureHighWarnThreshold, l...)
 		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureLowWarnThresholdDesc, prometheus.GaugeValue, d.ModuleTemperatureLowWarnThreshold, l...)
+		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureHighAlarmDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleTemperatureHighAlarm), l...)
+		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureLowAlarmDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleTemperatureLowAlarm), l...)
+		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureHighWarnDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleTemperatureHighWarn), l...)
+		ch <- prometheus.MustNewConstMetric(c.moduleTemperatureLowWarnDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleTemperatureLowWarn), l...)
 
 		if d.ModuleVoltage > 0 {
 			ch <- prometheus.MustNewConstMetric(c.moduleVoltageDesc, prometheus.GaugeValue, d.ModuleVoltage, l...)
@@ -217,6 +292,10 @@ func (c *interfaceDiagnosticsCollector) Collect(client collector.Client, ch chan
 			ch <- prometheus.MustNewConstMetric(c.moduleVoltageLowAlarmThresholdDesc, prometheus.GaugeValue, d.ModuleVoltageLowAlarmThreshold, l...)
 			ch <- prometheus.MustNewConstMetric(c.moduleVoltageHighWarnThresholdDesc, prometheus.GaugeValue, d.ModuleVoltageHighWarnThreshold, l...)
 			ch <- prometheus.MustNewConstMetric(c.moduleVoltageLowWarnThresholdDesc, prometheus.GaugeValue, d.ModuleVoltageLowWarnThreshold, l...)
+			ch <- prometheus.MustNewConstMetric(c.moduleVoltageHighAlarmDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleVoltageHighAlarm), l...)
+			ch <- prometheus.MustNewConstMetric(c.moduleVoltageLowAlarmDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleVoltageLowAlarm), l...)
+			ch <- prometheus.MustNewConstMetric(c.moduleVoltageHighWarnDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleVoltageHighWarn), l...)
+			ch <- prometheus.MustNewConstMetric(c.moduleVoltageLowWarnDesc, prometheus.GaugeValue, boolToFloat64(d.ModuleVoltageLowWarn), l...)
 		}
 
 		if d.RxSignalAvgOpticalPower > 0 {
@@ -258,6 +337,19 @@ func (c *interfaceDiagnosticsCollector) Collect(client collector.Client, ch chan
 			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerLowAlarmThresholdDbmDesc, prometheus.GaugeValue, d.LaserRxOpticalPowerLowAlarmThresholdDbm, l2...)
 			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerHighWarnThresholdDbmDesc, prometheus.GaugeValue, d.LaserRxOpticalPowerHighWarnThresholdDbm, l2...)
 			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerLowWarnThresholdDbmDesc, prometheus.GaugeValue, d.LaserRxOpticalPowerLowWarnThresholdDbm, l2...)
+
+			ch <- prometheus.MustNewConstMetric(c.laserBiasCurrentHighAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserBiasCurrentHighAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserBiasCurrentLowAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserBiasCurrentLowAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserBiasCurrentHighWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserBiasCurrentHighWarn), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserBiasCurrentLowWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserBiasCurrentLowWarn), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserOutputPowerHighAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserOutputPowerHighAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserOutputPowerLowAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserOutputPowerLowAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserOutputPowerHighWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserOutputPowerHighWarn), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserOutputPowerLowWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserOutputPowerLowWarn), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerHighAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserRxOpticalPowerHighAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerLowAlarmDesc, prometheus.GaugeValue, boolToFloat64(e.LaserRxOpticalPowerLowAlarm), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerHighWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserRxOpticalPowerHighWarn), l2...)
+			ch <- prometheus.MustNewConstMetric(c.laserRxOpticalPowerLowWarnDesc, prometheus.GaugeValue, boolToFloat64(e.LaserRxOpticalPowerLowWarn), l2...)
 		}
 	}
 
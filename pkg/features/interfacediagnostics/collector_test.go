@@ -3,6 +3,7 @@
 package interfacediagnostics
 
 import (
+	"encoding/xml"
 	"math"
 	"testing"
 
@@ -132,3 +133,73 @@ func TestInterfaceDiagnosticsFromRPCResult(t *testing.T) {
 	assert.Equal(t, float64(11), l.LaserRxOpticalPower)
 	assert.Equal(t, math.Inf(-1), l.LaserRxOpticalPowerDbm)
 }
+
+func TestInterfaceDiagnosticsFlags(t *testing.T) {
+	body := `<rpc-reply>
+<interface-information>
+    <physical-interface>
+        <name>xe-0/0/1</name>
+        <optics-diagnostics>
+            <laser-bias-current>6.120</laser-bias-current>
+            <laser-bias-current-high-alarm>off</laser-bias-current-high-alarm>
+            <laser-bias-current-low-alarm>off</laser-bias-current-low-alarm>
+            <laser-bias-current-high-warn>off</laser-bias-current-high-warn>
+            <laser-bias-current-low-warn>on</laser-bias-current-low-warn>
+            <laser-tx-power-high-alarm>off</laser-tx-power-high-alarm>
+            <laser-tx-power-low-alarm>off</laser-tx-power-low-alarm>
+            <laser-rx-power-high-alarm>off</laser-rx-power-high-alarm>
+            <laser-rx-power-low-alarm>on</laser-rx-power-low-alarm>
+            <laser-rx-power-low-warn>on</laser-rx-power-low-warn>
+            <module-temperature-high-alarm>off</module-temperature-high-alarm>
+            <module-temperature-high-warn>on</module-temperature-high-warn>
+            <module-voltage-low-alarm>on</module-voltage-low-alarm>
+        </optics-diagnostics>
+    </physical-interface>
+    <physical-interface>
+        <name>et-0/0/2</name>
+        <optics-diagnostics>
+            <module-temperature-high-alarm>off</module-temperature-high-alarm>
+            <optics-diagnostics-lane-values>
+                <lane-index>0</lane-index>
+                <laser-bias-current>40.000</laser-bias-current>
+                <laser-tx-power-low-warn>off</laser-tx-power-low-warn>
+            </optics-diagnostics-lane-values>
+            <optics-diagnostics-lane-values>
+                <lane-index>1</lane-index>
+                <laser-bias-current>0.000</laser-bias-current>
+                <laser-bias-current-low-alarm>on</laser-bias-current-low-alarm>
+                <laser-tx-power-low-alarm>on</laser-tx-power-low-alarm>
+                <laser-rx-power-high-warn>on</laser-rx-power-high-warn>
+            </optics-diagnostics-lane-values>
+        </optics-diagnostics>
+    </physical-interface>
+</interface-information>
+</rpc-reply>`
+
+	var res result
+	err := xml.Unmarshal([]byte(body), &res)
+	assert.NoError(t, err)
+
+	ifaces := interfaceDiagnosticsFromRPCResult(res)
+	assert.Equal(t, 2, len(ifaces), "interface count")
+
+	sfp := ifaces[0]
+	assert.True(t, sfp.LaserBiasCurrentLowWarn)
+	assert.False(t, sfp.LaserBiasCurrentHighAlarm)
+	assert.False(t, sfp.LaserOutputPowerLowAlarm)
+	assert.True(t, sfp.LaserRxOpticalPowerLowAlarm)
+	assert.True(t, sfp.LaserRxOpticalPowerLowWarn)
+	assert.False(t, sfp.LaserRxOpticalPowerHighAlarm)
+	assert.False(t, sfp.ModuleTemperatureHighAlarm)
+	assert.True(t, sfp.ModuleTemperatureHighWarn)
+	assert.True(t, sfp.ModuleVoltageLowAlarm)
+	assert.False(t, sfp.ModuleVoltageHighAlarm)
+
+	qsfp := ifaces[1]
+	assert.Equal(t, 2, len(qsfp.Lanes), "lane count")
+	assert.False(t, qsfp.Lanes[0].LaserBiasCurrentLowAlarm)
+	assert.False(t, qsfp.Lanes[0].LaserOutputPowerLowWarn)
+	assert.True(t, qsfp.Lanes[1].LaserBiasCurrentLowAlarm)
+	assert.True(t, qsfp.Lanes[1].LaserOutputPowerLowAlarm)
+	assert.True(t, qsfp.Lanes[1].LaserRxOpticalPowerHighWarn)
+}
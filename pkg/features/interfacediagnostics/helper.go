@@ -17,6 +17,35 @@ func dbmStringToFloat(value string) float64 {
 	return math.Inf(-1)
 }
 
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func flagIsSet(value string) bool {
+	return strings.EqualFold(strings.TrimSpace(value), "on")
+}
+
+func setLaserFlags(d *interfaceDiagnostics, f laserFlags) {
+	d.LaserBiasCurrentHighAlarm = flagIsSet(f.LaserBiasCurrentHighAlarm)
+	d.LaserBiasCurrentLowAlarm = flagIsSet(f.LaserBiasCurrentLowAlarm)
+	d.LaserBiasCurrentHighWarn = flagIsSet(f.LaserBiasCurrentHighWarn)
+	d.LaserBiasCurrentLowWarn = flagIsSet(f.LaserBiasCurrentLowWarn)
+
+	d.LaserOutputPowerHighAlarm = flagIsSet(f.LaserTxPowerHighAlarm)
+	d.LaserOutputPowerLowAlarm = flagIsSet(f.LaserTxPowerLowAlarm)
+	d.LaserOutputPowerHighWarn = flagIsSet(f.LaserTxPowerHighWarn)
+	d.LaserOutputPowerLowWarn = flagIsSet(f.LaserTxPowerLowWarn)
+
+	d.LaserRxOpticalPowerHighAlarm = flagIsSet(f.LaserRxPowerHighAlarm)
+	d.LaserRxOpticalPowerLowAlarm = flagIsSet(f.LaserRxPowerLowAlarm)
+	d.LaserRxOpticalPowerHighWarn = flagIsSet(f.LaserRxPowerHighWarn)
+	d.LaserRxOpticalPowerLowWarn = flagIsSet(f.LaserRxPowerLowWarn)
+}
+
 func interfaceDiagnosticsFromRPCResult(res result) []*interfaceDiagnostics {
 	diagnostics := make([]*interfaceDiagnostics, 0)
 
@@ -71,7 +100,18 @@ func interfaceDiagnosticsFromRPCResult(res result) []*interfaceDiagnostics {
 			LaserRxOpticalPowerLowAlarmThresholdDbm:  dbmStringToFloat(diag.Diagnostics.LaserRxOpticalPowerLowAlarmThresholdDbm),
 			LaserRxOpticalPowerHighWarnThresholdDbm:  dbmStringToFloat(diag.Diagnostics.LaserRxOpticalPowerHighWarnThresholdDbm),
 			LaserRxOpticalPowerLowWarnThresholdDbm:   dbmStringToFloat(diag.Diagnostics.LaserRxOpticalPowerLowWarnThresholdDbm),
+
+			ModuleTemperatureHighAlarm: flagIsSet(diag.Diagnostics.ModuleTemperatureHighAlarm),
+			ModuleTemperatureLowAlarm:  flagIsSet(diag.Diagnostics.ModuleTemperatureLowAlarm),
+			ModuleTemperatureHighWarn:  flagIsSet(diag.Diagnostics.ModuleTemperatureHighWarn),
+			ModuleTemperatureLowWarn:   flagIsSet(diag.Diagnostics.ModuleTemperatureLowWarn),
+
+			ModuleVoltageHighAlarm: flagIsSet(diag.Diagnostics.ModuleVoltageHighAlarm),
+			ModuleVoltageLowAlarm:  flagIsSet(diag.Diagnostics.ModuleVoltageLowAlarm),
+			ModuleVoltageHighWarn:  flagIsSet(diag.Diagnostics.ModuleVoltageHighWarn),
+			ModuleVoltageLowWarn:   flagIsSet(diag.Diagnostics.ModuleVoltageLowWarn),
 		}
+		setLaserFlags(d, diag.Diagnostics.laserFlags)
 
 		if len(diag.Diagnostics.Lanes) > 0 {
 			for _, lane := range diag.Diagnostics.Lanes {
@@ -84,6 +124,7 @@ func interfaceDiagnosticsFromRPCResult(res result) []*interfaceDiagnostics {
 					LaserRxOpticalPower:    float64(lane.LaserRxOpticalPower),
 					LaserRxOpticalPowerDbm: dbmStringToFloat(lane.LaserRxOpticalPowerDbm),
 				}
+				setLaserFlags(l, lane.laserFlags)
 
 				d.Lanes = append(d.Lanes, l)
 			}
@@ -49,5 +49,30 @@ type interfaceDiagnostics struct {
 	RxSignalAvgOpticalPower         float64
 	RxSignalAvgOpticalPowerDbm      float64
 
+	ModuleTemperatureHighAlarm bool
+	ModuleTemperatureLowAlarm  bool
+	ModuleTemperatureHighWarn  bool
+	ModuleTemperatureLowWarn   bool
+
+	ModuleVoltageHighAlarm bool
+	ModuleVoltageLowAlarm  bool
+	ModuleVoltageHighWarn  bool
+	ModuleVoltageLowWarn   bool
+
+	LaserBiasCurrentHighAlarm bool
+	LaserBiasCurrentLowAlarm  bool
+	LaserBiasCurrentHighWarn  bool
+	LaserBiasCurrentLowWarn   bool
+
+	LaserOutputPowerHighAlarm bool
+	LaserOutputPowerLowAlarm  bool
+	LaserOutputPowerHighWarn  bool
+	LaserOutputPowerLowWarn   bool
+
+	LaserRxOpticalPowerHighAlarm bool
+	LaserRxOpticalPowerLowAlarm  bool
+	LaserRxOpticalPowerHighWarn  bool
+	LaserRxOpticalPowerLowWarn   bool
+
 	Lanes []*interfaceDiagnostics
 }
@@ -179,6 +179,18 @@ type phyInterfaceDiagnostic struct {
 	LaserTxOpticalPowerHighWarnThresholdDbm  string `xml:"laser-tx-power-high-warn-threshold-dbm,omitempty"`
 	LaserTxOpticalPowerLowWarnThresholdDbm   string `xml:"laser-tx-power-low-warn-threshold-dbm,omitempty"`
 
+	ModuleTemperatureHighAlarm string `xml:"module-temperature-high-alarm,omitempty"`
+	ModuleTemperatureLowAlarm  string `xml:"module-temperature-low-alarm,omitempty"`
+	ModuleTemperatureHighWarn  string `xml:"module-temperature-high-warn,omitempty"`
+	ModuleTemperatureLowWarn   string `xml:"module-temperature-low-warn,omitempty"`
+
+	ModuleVoltageHighAlarm string `xml:"module-voltage-high-alarm,omitempty"`
+	ModuleVoltageLowAlarm  string `xml:"module-voltage-low-alarm,omitempty"`
+	ModuleVoltageHighWarn  string `xml:"module-voltage-high-warn,omitempty"`
+	ModuleVoltageLowWarn   string `xml:"module-voltage-low-warn,omitempty"`
+
+	laserFlags
+
 	NA string `xml:"optic-diagnostics-not-available"`
 
 	Lanes []lane `xml:"optics-diagnostics-lane-values,omitempty"`
@@ -195,4 +207,24 @@ type lane struct {
 	LaserOutputPowerDbm    string  `xml:"laser-output-power-dbm,omitempty"`
 	LaserRxOpticalPower    float64 `xml:"laser-rx-optical-power,omitempty"`
 	LaserRxOpticalPowerDbm string  `xml:"laser-rx-optical-power-dbm,omitempty"`
+
+	laserFlags
+}
+
+// laserFlags are the alarm and warning flags reported per module (single lane optics) or per lane
+type laserFlags struct {
+	LaserBiasCurrentHighAlarm string `xml:"laser-bias-current-high-alarm,omitempty"`
+	LaserBiasCurrentLowAlarm  string `xml:"laser-bias-current-low-alarm,omitempty"`
+	LaserBiasCurrentHighWarn  string `xml:"laser-bias-current-high-warn,omitempty"`
+	LaserBiasCurrentLowWarn   string `xml:"laser-bias-current-low-warn,omitempty"`
+
+	LaserTxPowerHighAlarm string `xml:"laser-tx-power-high-alarm,omitempty"`
+	LaserTxPowerLowAlarm  string `xml:"laser-tx-power-low-alarm,omitempty"`
+	LaserTxPowerHighWarn  string `xml:"laser-tx-power-high-warn,omitempty"`
+	LaserTxPowerLowWarn   string `xml:"laser-tx-power-low-warn,omitempty"`
+
+	LaserRxPowerHighAlarm string `xml:"laser-rx-power-high-alarm,omitempty"`
+	LaserRxPowerLowAlarm  string `xml:"laser-rx-power-low-alarm,omitempty"`
+	LaserRxPowerHighWarn  string `xml:"laser-rx-power-high-warn,omitempty"`
+	LaserRxPowerLowWarn   string `xml:"laser-rx-power-low-warn,omitempty"`
 }
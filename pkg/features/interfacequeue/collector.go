@@ -5,16 +5,18 @@ package interfacequeue
 import (
 	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/features/interfaces"
 	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 const prefix = "junos_interface_queues_"
 
-// NewCollector creates an queue collector instance
-func NewCollector(labels *interfacelabels.DynamicLabels) collector.RPCCollector {
+// NewCollector creates an queue collector instance. Only interfaces matching the filter of a device are collected.
+func NewCollector(labels *interfacelabels.DynamicLabels, filterForDevice interfaces.FilterFunc) collector.RPCCollector {
 	c := &interfaceQueueCollector{
-		labels: labels,
+		labels:          labels,
+		filterForDevice: filterForDevice,
 	}
 	c.init()
 
@@ -23,6 +25,7 @@ func NewCollector(labels *interfacelabels.DynamicLabels) collector.RPCCollector
 
 type interfaceQueueCollector struct {
 	labels               *interfacelabels.DynamicLabels
+	filterForDevice      interfaces.FilterFunc
 	queuedPackets        *prometheus.Desc
 	queuedBytes          *prometheus.Desc
 	transferedPackets    *prometheus.Desc
@@ -52,7 +55,7 @@ func (*interfaceQueueCollector) Name() string {
 func (c *interfaceQueueCollector) init() {
 	l := []string{"target", "name", "description"}
 	l = append(l, c.labels.LabelNames()...)
-	l = append(l, "queue_number")
+	l = append(l, "queue_number", "forwarding_class")
 
 	c.queuedPackets = prometheus.NewDesc(prefix+"queued_packets_count", "Number of queued packets", l, nil)
 	c.queuedBytes = prometheus.NewDesc(prefix+"queued_bytes_count", "Number of bytes of queued packets", l, nil)
@@ -107,7 +110,17 @@ func (c *interfaceQueueCollector) Collect(client collector.Client, ch chan<- pro
 		return err
 	}
 
+	var filter *interfaces.Filter
+	if c.filterForDevice != nil {
+		filter = c.filterForDevice(client.Device().Host)
+	}
+
+	collector.ReportRecords(client, len(q.InterfaceInformation.Interfaces))
 	for _, iface := range q.InterfaceInformation.Interfaces {
+		if !filter.Matches(iface.Name) {
+			continue
+		}
+
 		c.collectForInterface(iface, client.Device(), ch, labelValues)
 	}
 
@@ -124,7 +137,7 @@ func (c *interfaceQueueCollector) collectForInterface(iface physicalInterface, d
 }
 
 func (c *interfaceQueueCollector) collectForQueue(queue queue, ch chan<- prometheus.Metric, labelValues []string) {
-	l := append(labelValues, queue.Number)
+	l := append(labelValues, queue.Number, queue.ForwardingClass)
 
 	ch <- prometheus.MustNewConstMetric(c.queuedPackets, prometheus.CounterValue, float64(queue.QueuedPackets), l...)
 	ch <- prometheus.MustNewConstMetric(c.queuedBytes, prometheus.CounterValue, float64(queue.QueuedBytes), l...)
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+
+package interfacequeue
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQueues(t *testing.T) {
+	body := `<rpc-reply>
+<interface-information>
+    <physical-interface>
+        <name>xe-0/0/0</name>
+        <description>uplink</description>
+        <queue-counters>
+            <interface-cos-summary>
+                <intf-cos-forwarding-classes-supported>16</intf-cos-forwarding-classes-supported>
+                <intf-cos-forwarding-classes-in-use>4</intf-cos-forwarding-classes-in-use>
+            </interface-cos-summary>
+            <queue>
+                <queue-number>0</queue-number>
+                <forwarding-class-name>best-effort</forwarding-class-name>
+                <queue-counters-queued-packets>1000</queue-counters-queued-packets>
+                <queue-counters-queued-bytes>150000</queue-counters-queued-bytes>
+                <queue-counters-trans-packets>990</queue-counters-trans-packets>
+                <queue-counters-trans-bytes>148500</queue-counters-trans-bytes>
+                <queue-counters-tail-drop-packets>7</queue-counters-tail-drop-packets>
+                <queue-counters-red-packets>3</queue-counters-red-packets>
+                <queue-counters-red-bytes>450</queue-counters-red-bytes>
+                <queue-counters-total-drop-packets>10</queue-counters-total-drop-packets>
+                <queue-counters-total-drop-bytes>1500</queue-counters-total-drop-bytes>
+            </queue>
+            <queue>
+                <queue-number>3</queue-number>
+                <forwarding-class-name>network-control</forwarding-class-name>
+                <queue-counters-trans-packets>42</queue-counters-trans-packets>
+            </queue>
+        </queue-counters>
+    </physical-interface>
+</interface-information>
+</rpc-reply>`
+
+	var res result
+	err := xml.Unmarshal([]byte(body), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(res.InterfaceInformation.Interfaces), "interface count")
+
+	iface := res.InterfaceInformation.Interfaces[0]
+	assert.Equal(t, "xe-0/0/0", iface.Name)
+	assert.Equal(t, "uplink", iface.Description)
+	assert.Equal(t, 2, len(iface.QueueCounters.Queues), "queue count")
+
+	q := iface.QueueCounters.Queues[0]
+	assert.Equal(t, "0", q.Number)
+	assert.Equal(t, "best-effort", q.ForwardingClass)
+	assert.Equal(t, uint64(1000), q.QueuedPackets)
+	assert.Equal(t, uint64(150000), q.QueuedBytes)
+	assert.Equal(t, uint64(990), q.TransferedPackets)
+	assert.Equal(t, uint64(148500), q.TransferedBytes)
+	assert.Equal(t, uint64(7), q.TailDropPackets)
+	assert.Equal(t, uint64(3), q.RedPackets)
+	assert.Equal(t, uint64(450), q.RedBytes)
+	assert.Equal(t, uint64(10), q.TotalDropPackets)
+	assert.Equal(t, uint64(1500), q.TotalDropBytes)
+
+	q = iface.QueueCounters.Queues[1]
+	assert.Equal(t, "3", q.Number)
+	assert.Equal(t, "network-control", q.ForwardingClass)
+	assert.Equal(t, uint64(42), q.TransferedPackets)
+}
@@ -17,6 +17,8 @@ const prefix = "junos_interface_"
 // Collector collects interface metrics
 type interfaceCollector struct {
 	labels                      *interfacelabels.DynamicLabels
+	filterForDevice             FilterFunc
+	rates                       bool
 	receiveBytesDesc            *prometheus.Desc
 	receivePacketsDesc          *prometheus.Desc
 	receiveErrorsDesc           *prometheus.Desc
@@ -33,8 +35,10 @@ type interfaceCollector struct {
 	ipv6transmitPacketsDesc     *prometheus.Desc
 	adminStatusDesc             *prometheus.Desc
 	operStatusDesc              *prometheus.Desc
+	operUpDesc                  *prometheus.Desc
 	errorStatusDesc             *prometheus.Desc
 	lastFlappedDesc             *prometheus.Desc
+	mtuDesc                     *prometheus.Desc
 	receiveUnicastsDesc         *prometheus.Desc
 	receiveBroadcastsDesc       *prometheus.Desc
 	receiveMulticastsDesc       *prometheus.Desc
@@ -54,12 +58,30 @@ type interfaceCollector struct {
 	receiveCodeViolationsDesc   *prometheus.Desc
 	receiveTotalErrorsDesc      *prometheus.Desc
 	transmitTotalErrorsDesc     *prometheus.Desc
+	receiveFramingErrorsDesc    *prometheus.Desc
+	receiveRuntsDesc            *prometheus.Desc
+	receiveDiscardsDesc         *prometheus.Desc
+	receiveL3IncompletesDesc    *prometheus.Desc
+	receiveFIFOErrorsDesc       *prometheus.Desc
+	receiveResourceErrorsDesc   *prometheus.Desc
+	carrierTransitionsDesc      *prometheus.Desc
+	transmitCollisionsDesc      *prometheus.Desc
+	transmitFIFOErrorsDesc      *prometheus.Desc
+	transmitResourceErrorsDesc  *prometheus.Desc
+	transmitMTUErrorsDesc       *prometheus.Desc
+	transmitAgedPacketsDesc     *prometheus.Desc
+	inputBPSDesc                *prometheus.Desc
+	inputPPSDesc                *prometheus.Desc
+	outputBPSDesc               *prometheus.Desc
+	outputPPSDesc               *prometheus.Desc
 }
 
-// NewCollector creates a new collector
-func NewCollector(labels *interfacelabels.DynamicLabels) collector.RPCCollector {
+// NewCollector creates a new collector, rates enables the input/output rates calculated by the device
+func NewCollector(labels *interfacelabels.DynamicLabels, filterForDevice FilterFunc, rates bool) collector.RPCCollector {
 	c := &interfaceCollector{
-		labels: labels,
+		labels:          labels,
+		filterForDevice: filterForDevice,
+		rates:           rates,
 	}
 	c.init()
 
@@ -91,8 +113,10 @@ func (c *interfaceCollector) init() {
 	c.ipv6transmitPacketsDesc = prometheus.NewDesc(prefix+"IPv6_transmit_packets_total", "Transmitted IPv6 packets", l, nil)
 	c.adminStatusDesc = prometheus.NewDesc(prefix+"admin_up", "Admin operational status", l, nil)
 	c.operStatusDesc = prometheus.NewDesc(prefix+"up", "Interface operational status", l, nil)
+	c.operUpDesc = prometheus.NewDesc(prefix+"oper_up", "Interface operational status (1 = up), logical interfaces included", l, nil)
 	c.errorStatusDesc = prometheus.NewDesc(prefix+"error_status", "Admin and operational status differ", l, nil)
-	c.lastFlappedDesc = prometheus.NewDesc(prefix+"last_flapped_seconds", "Seconds since last flapped (-1 if never)", l, nil)
+	c.lastFlappedDesc = prometheus.NewDesc(prefix+"last_flapped_seconds", "Unix timestamp of the last flap of the interface (-1 if never)", l, nil)
+	c.mtuDesc = prometheus.NewDesc(prefix+"mtu", "MTU of the physical interface in bytes", l, nil)
 	c.receiveUnicastsDesc = prometheus.NewDesc(prefix+"receive_unicasts_packets", "Received unicast packets", l, nil)
 	c.receiveBroadcastsDesc = prometheus.NewDesc(prefix+"receive_broadcasts_packets", "Received broadcast packets", l, nil)
 	c.receiveMulticastsDesc = prometheus.NewDesc(prefix+"receive_multicasts_packets", "Received multicast packets", l, nil)
@@ -112,6 +136,22 @@ func (c *interfaceCollector) init() {
 	c.receiveCodeViolationsDesc = prometheus.NewDesc(prefix+"receive_code_violations", "Number of received Code Violations", l, nil)
 	c.receiveTotalErrorsDesc = prometheus.NewDesc(prefix+"receive_total_errors", "Number of received Total Errors", l, nil)
 	c.transmitTotalErrorsDesc = prometheus.NewDesc(prefix+"transmit_total_errors", "Number of transmitted Total Errors", l, nil)
+	c.receiveFramingErrorsDesc = prometheus.NewDesc(prefix+"receive_framing_errors", "Number of incoming packets with framing errors", l, nil)
+	c.receiveRuntsDesc = prometheus.NewDesc(prefix+"receive_runts", "Number of incoming frames shorter than the minimum frame size", l, nil)
+	c.receiveDiscardsDesc = prometheus.NewDesc(prefix+"receive_discards", "Number of incoming packets discarded (e.g. unknown protocol)", l, nil)
+	c.receiveL3IncompletesDesc = prometheus.NewDesc(prefix+"receive_l3_incompletes", "Number of incoming packets failing the L3 header checks", l, nil)
+	c.receiveFIFOErrorsDesc = prometheus.NewDesc(prefix+"receive_fifo_errors", "Number of FIFO errors in receive direction", l, nil)
+	c.receiveResourceErrorsDesc = prometheus.NewDesc(prefix+"receive_resource_errors", "Number of resource errors in receive direction", l, nil)
+	c.carrierTransitionsDesc = prometheus.NewDesc(prefix+"carrier_transitions", "Number of times the carrier of the interface went from down to up", l, nil)
+	c.transmitCollisionsDesc = prometheus.NewDesc(prefix+"transmit_collisions", "Number of collisions of outgoing packets", l, nil)
+	c.transmitFIFOErrorsDesc = prometheus.NewDesc(prefix+"transmit_fifo_errors", "Number of FIFO errors in transmit direction", l, nil)
+	c.transmitResourceErrorsDesc = prometheus.NewDesc(prefix+"transmit_resource_errors", "Number of resource errors in transmit direction", l, nil)
+	c.transmitMTUErrorsDesc = prometheus.NewDesc(prefix+"transmit_mtu_errors", "Number of outgoing packets exceeding the MTU", l, nil)
+	c.transmitAgedPacketsDesc = prometheus.NewDesc(prefix+"transmit_aged_packets", "Number of outgoing packets aged out in the shared packet memory", l, nil)
+	c.inputBPSDesc = prometheus.NewDesc(prefix+"input_bps", "Input rate in bits per second as calculated by the device", l, nil)
+	c.inputPPSDesc = prometheus.NewDesc(prefix+"input_pps", "Input rate in packets per second as calculated by the device", l, nil)
+	c.outputBPSDesc = prometheus.NewDesc(prefix+"output_bps", "Output rate in bits per second as calculated by the device", l, nil)
+	c.outputPPSDesc = prometheus.NewDesc(prefix+"output_pps", "Output rate in packets per second as calculated by the device", l, nil)
 
 }
 
@@ -133,8 +173,10 @@ func (c *interfaceCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.ipv6transmitPacketsDesc
 	ch <- c.adminStatusDesc
 	ch <- c.operStatusDesc
+	ch <- c.operUpDesc
 	ch <- c.errorStatusDesc
 	ch <- c.lastFlappedDesc
+	ch <- c.mtuDesc
 	ch <- c.receiveUnicastsDesc
 	ch <- c.receiveBroadcastsDesc
 	ch <- c.receiveMulticastsDesc
@@ -154,6 +196,25 @@ func (c *interfaceCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.receiveCodeViolationsDesc
 	ch <- c.receiveTotalErrorsDesc
 	ch <- c.transmitTotalErrorsDesc
+	ch <- c.receiveFramingErrorsDesc
+	ch <- c.receiveRuntsDesc
+	ch <- c.receiveDiscardsDesc
+	ch <- c.receiveL3IncompletesDesc
+	ch <- c.receiveFIFOErrorsDesc
+	ch <- c.receiveResourceErrorsDesc
+	ch <- c.carrierTransitionsDesc
+	ch <- c.transmitCollisionsDesc
+	ch <- c.transmitFIFOErrorsDesc
+	ch <- c.transmitResourceErrorsDesc
+	ch <- c.transmitMTUErrorsDesc
+	ch <- c.transmitAgedPacketsDesc
+
+	if c.rates {
+		ch <- c.inputBPSDesc
+		ch <- c.inputPPSDesc
+		ch <- c.outputBPSDesc
+		ch <- c.outputPPSDesc
+	}
 }
 
 // Collect collects metrics from JunOS
@@ -162,8 +223,18 @@ func (c *interfaceCollector) Collect(client collector.Client, ch chan<- promethe
 	if err != nil {
 		return err
 	}
+	collector.ReportRecords(client, len(stats))
+
+	var filter *Filter
+	if c.filterForDevice != nil {
+		filter = c.filterForDevice(client.Device().Host)
+	}
 
 	for _, s := range stats {
+		if !filter.Matches(s.Name) {
+			continue
+		}
+
 		c.collectForInterface(s, client.Device(), ch, labelValues)
 	}
 
@@ -192,11 +263,16 @@ func (c *interfaceCollector) interfaceStats(client collector.Client) ([]*interfa
 			ReceiveBytes:            float64(phy.Stats.InputBytes),
 			ReceivePackets:          float64(phy.Stats.InputPackets),
 			Speed:                   phy.Speed,
+			MTU:                     phy.MTU,
 			BPDUError:               phy.BPDUError == "detected",
 			TransmitDrops:           float64(phy.OutputErrors.Drops),
 			TransmitErrors:          float64(phy.OutputErrors.Errors),
 			TransmitBytes:           float64(phy.Stats.OutputBytes),
 			TransmitPackets:         float64(phy.Stats.OutputPackets),
+			ReceiveBPS:              float64(phy.Stats.InputBPS),
+			ReceivePPS:              float64(phy.Stats.InputPPS),
+			TransmitBPS:             float64(phy.Stats.OutputBPS),
+			TransmitPPS:             float64(phy.Stats.OutputPPS),
 			IPv6ReceiveBytes:        float64(phy.Stats.IPv6Traffic.InputBytes),
 			IPv6ReceivePackets:      float64(phy.Stats.IPv6Traffic.InputPackets),
 			IPv6TransmitBytes:       float64(phy.Stats.IPv6Traffic.OutputBytes),
@@ -221,6 +297,18 @@ func (c *interfaceCollector) interfaceStats(client collector.Client) ([]*interfa
 			ReceiveCodeViolations:   float64(phy.MACStatistics.InputCodeViolations),
 			ReceiveTotalErrors:      float64(phy.MACStatistics.InputTotalErrors),
 			TransmitTotalErrors:     float64(phy.MACStatistics.OutputTotalErrors),
+			ReceiveFramingErrors:    float64(phy.InputErrors.FramingErrors),
+			ReceiveRunts:            float64(phy.InputErrors.Runts),
+			ReceiveDiscards:         float64(phy.InputErrors.Discards),
+			ReceiveL3Incompletes:    float64(phy.InputErrors.L3Incompletes),
+			ReceiveFIFOErrors:       float64(phy.InputErrors.FIFOErrors),
+			ReceiveResourceErrors:   float64(phy.InputErrors.ResourceErrors),
+			CarrierTransitions:      float64(phy.OutputErrors.CarrierTransitions),
+			TransmitCollisions:      float64(phy.OutputErrors.Collisions),
+			TransmitFIFOErrors:      float64(phy.OutputErrors.FIFOErrors),
+			TransmitResourceErrors:  float64(phy.OutputErrors.ResourceErrors),
+			TransmitMTUErrors:       float64(phy.OutputErrors.MTUErrors),
+			TransmitAgedPackets:     float64(phy.OutputErrors.AgedPackets),
 		}
 
 		if phy.InterfaceFlapped.Value != "Never" {
@@ -239,12 +327,18 @@ func (c *interfaceCollector) interfaceStats(client collector.Client) ([]*interfa
 			sl := &interfaceStats{
 				IsPhysical:          false,
 				Name:                log.Name,
+				AdminStatus:         log.ConfigFlags.Down == nil,
+				OperStatus:          log.ConfigFlags.Up != nil,
 				Description:         log.Description,
 				Mac:                 phy.MacAddress,
 				ReceiveBytes:        float64(s.InputBytes),
 				ReceivePackets:      float64(s.InputPackets),
 				TransmitBytes:       float64(s.OutputBytes),
 				TransmitPackets:     float64(s.OutputPackets),
+				ReceiveBPS:          float64(s.InputBPS),
+				ReceivePPS:          float64(s.InputPPS),
+				TransmitBPS:         float64(s.OutputBPS),
+				TransmitPPS:         float64(s.OutputPPS),
 				IPv6ReceiveBytes:    float64(s.IPv6Traffic.InputBytes),
 				IPv6ReceivePackets:  float64(s.IPv6Traffic.InputPackets),
 				IPv6TransmitBytes:   float64(s.IPv6Traffic.OutputBytes),
@@ -271,15 +365,25 @@ func (c *interfaceCollector) collectForInterface(s *interfaceStats, device *conn
 	ch <- prometheus.MustNewConstMetric(c.ipv6transmitBytesDesc, prometheus.CounterValue, s.IPv6TransmitBytes, l...)
 	ch <- prometheus.MustNewConstMetric(c.ipv6transmitPacketsDesc, prometheus.CounterValue, s.IPv6TransmitPackets, l...)
 
+	if c.rates {
+		ch <- prometheus.MustNewConstMetric(c.inputBPSDesc, prometheus.GaugeValue, s.ReceiveBPS, l...)
+		ch <- prometheus.MustNewConstMetric(c.inputPPSDesc, prometheus.GaugeValue, s.ReceivePPS, l...)
+		ch <- prometheus.MustNewConstMetric(c.outputBPSDesc, prometheus.GaugeValue, s.TransmitBPS, l...)
+		ch <- prometheus.MustNewConstMetric(c.outputPPSDesc, prometheus.GaugeValue, s.TransmitPPS, l...)
+	}
+
+	adminUp := 0
+	if s.AdminStatus {
+		adminUp = 1
+	}
+	operUp := 0
+	if s.OperStatus {
+		operUp = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.adminStatusDesc, prometheus.GaugeValue, float64(adminUp), l...)
+	ch <- prometheus.MustNewConstMetric(c.operUpDesc, prometheus.GaugeValue, float64(operUp), l...)
+
 	if s.IsPhysical {
-		adminUp := 0
-		if s.AdminStatus {
-			adminUp = 1
-		}
-		operUp := 0
-		if s.OperStatus {
-			operUp = 1
-		}
 		err := 0
 		if s.ErrorStatus {
 			err = 1
@@ -313,7 +417,6 @@ func (c *interfaceCollector) collectForInterface(s *interfaceStats, device *conn
 			ch <- prometheus.MustNewConstMetric(c.interfaceBPDUErrorDesc, prometheus.GaugeValue, float64(1), l...)
 		}
 
-		ch <- prometheus.MustNewConstMetric(c.adminStatusDesc, prometheus.GaugeValue, float64(adminUp), l...)
 		ch <- prometheus.MustNewConstMetric(c.operStatusDesc, prometheus.GaugeValue, float64(operUp), l...)
 		ch <- prometheus.MustNewConstMetric(c.errorStatusDesc, prometheus.GaugeValue, float64(err), l...)
 		ch <- prometheus.MustNewConstMetric(c.transmitErrorsDesc, prometheus.CounterValue, s.TransmitErrors, l...)
@@ -326,6 +429,10 @@ func (c *interfaceCollector) collectForInterface(s *interfaceStats, device *conn
 			ch <- prometheus.MustNewConstMetric(c.lastFlappedDesc, prometheus.GaugeValue, s.LastFlapped, l...)
 		}
 
+		if mtu, err := strconv.ParseFloat(strings.TrimSpace(s.MTU), 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.mtuDesc, prometheus.GaugeValue, mtu, l...)
+		}
+
 		ch <- prometheus.MustNewConstMetric(c.receiveUnicastsDesc, prometheus.CounterValue, s.ReceiveUnicasts, l...)
 		ch <- prometheus.MustNewConstMetric(c.receiveBroadcastsDesc, prometheus.CounterValue, s.ReceiveBroadcasts, l...)
 		ch <- prometheus.MustNewConstMetric(c.receiveMulticastsDesc, prometheus.CounterValue, s.ReceiveMulticasts, l...)
@@ -345,6 +452,18 @@ func (c *interfaceCollector) collectForInterface(s *interfaceStats, device *conn
 		ch <- prometheus.MustNewConstMetric(c.receiveCodeViolationsDesc, prometheus.CounterValue, s.ReceiveCodeViolations, l...)
 		ch <- prometheus.MustNewConstMetric(c.receiveTotalErrorsDesc, prometheus.CounterValue, s.ReceiveTotalErrors, l...)
 		ch <- prometheus.MustNewConstMetric(c.transmitTotalErrorsDesc, prometheus.CounterValue, s.TransmitTotalErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveFramingErrorsDesc, prometheus.CounterValue, s.ReceiveFramingErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveRuntsDesc, prometheus.CounterValue, s.ReceiveRunts, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveDiscardsDesc, prometheus.CounterValue, s.ReceiveDiscards, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveL3IncompletesDesc, prometheus.CounterValue, s.ReceiveL3Incompletes, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveFIFOErrorsDesc, prometheus.CounterValue, s.ReceiveFIFOErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.receiveResourceErrorsDesc, prometheus.CounterValue, s.ReceiveResourceErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.carrierTransitionsDesc, prometheus.CounterValue, s.CarrierTransitions, l...)
+		ch <- prometheus.MustNewConstMetric(c.transmitCollisionsDesc, prometheus.CounterValue, s.TransmitCollisions, l...)
+		ch <- prometheus.MustNewConstMetric(c.transmitFIFOErrorsDesc, prometheus.CounterValue, s.TransmitFIFOErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.transmitResourceErrorsDesc, prometheus.CounterValue, s.TransmitResourceErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.transmitMTUErrorsDesc, prometheus.CounterValue, s.TransmitMTUErrors, l...)
+		ch <- prometheus.MustNewConstMetric(c.transmitAgedPacketsDesc, prometheus.CounterValue, s.TransmitAgedPackets, l...)
 
 	}
 }
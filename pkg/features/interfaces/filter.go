@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+
+package interfaces
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// Filter selects interfaces by name
+type Filter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// FilterFunc returns the filter for a device (nil if all interfaces are collected)
+type FilterFunc func(host string) *Filter
+
+// NewFilter creates a new filter using regular expressions matched against the interface name
+func NewFilter(include, exclude []string) (*Filter, error) {
+	f := &Filter{}
+
+	for _, s := range include {
+		r, err := regexp.Compile(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid include pattern %s", s)
+		}
+
+		f.include = append(f.include, r)
+	}
+
+	for _, s := range exclude {
+		r, err := regexp.Compile(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid exclude pattern %s", s)
+		}
+
+		f.exclude = append(f.exclude, r)
+	}
+
+	return f, nil
+}
+
+// Matches returns if metrics for the interface should be collected.
+// Interfaces matching an include pattern are always collected, interfaces matching an exclude pattern are skipped.
+// Interfaces matching neither are skipped only if include patterns but no exclude patterns are defined.
+func (f *Filter) Matches(name string) bool {
+	if f == nil {
+		return true
+	}
+
+	if matchesAny(f.include, name) {
+		return true
+	}
+
+	if matchesAny(f.exclude, name) {
+		return false
+	}
+
+	return len(f.include) == 0 || len(f.exclude) > 0
+}
+
+func matchesAny(regexes []*regexp.Regexp, name string) bool {
+	for _, r := range regexes {
+		if r.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
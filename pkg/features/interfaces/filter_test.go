@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+
+package interfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterMatches(t *testing.T) {
+	var none *Filter
+	assert.True(t, none.Matches("ge-0/0/0"), "no filter")
+
+	f, err := NewFilter([]string{`^ae0\.`}, []string{`\.\d+$`})
+	assert.NoError(t, err)
+	assert.True(t, f.Matches("ge-0/0/0"), "physical interface")
+	assert.False(t, f.Matches("ge-0/0/0.0"), "excluded logical interface")
+	assert.True(t, f.Matches("ae0.100"), "included takes precedence")
+
+	f, err = NewFilter([]string{`^(ae|xe-)`}, nil)
+	assert.NoError(t, err)
+	assert.True(t, f.Matches("xe-0/0/1"), "included")
+	assert.False(t, f.Matches("ge-0/0/1"), "not included")
+
+	_, err = NewFilter(nil, []string{`(`})
+	assert.Error(t, err)
+}
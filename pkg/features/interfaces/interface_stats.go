@@ -11,6 +11,7 @@ type interfaceStats struct {
 	Mac                     string
 	IsPhysical              bool
 	Speed                   string
+	MTU                     string
 	BPDUError               bool
 	ReceiveBytes            float64
 	ReceivePackets          float64
@@ -20,6 +21,10 @@ type interfaceStats struct {
 	TransmitPackets         float64
 	TransmitErrors          float64
 	TransmitDrops           float64
+	ReceiveBPS              float64
+	ReceivePPS              float64
+	TransmitBPS             float64
+	TransmitPPS             float64
 	IPv6ReceiveBytes        float64
 	IPv6ReceivePackets      float64
 	IPv6TransmitBytes       float64
@@ -44,4 +49,16 @@ type interfaceStats struct {
 	ReceiveCodeViolations   float64
 	ReceiveTotalErrors      float64
 	TransmitTotalErrors     float64
+	ReceiveFramingErrors    float64
+	ReceiveRunts            float64
+	ReceiveDiscards         float64
+	ReceiveL3Incompletes    float64
+	ReceiveFIFOErrors       float64
+	ReceiveResourceErrors   float64
+	CarrierTransitions      float64
+	TransmitCollisions      float64
+	TransmitFIFOErrors      float64
+	TransmitResourceErrors  float64
+	TransmitMTUErrors       float64
+	TransmitAgedPackets     float64
 }
@@ -15,16 +15,29 @@ type phyInterface struct {
 	Description       string         `xml:"description"`
 	MacAddress        string         `xml:"current-physical-address"`
 	Speed             string         `xml:"speed"`
+	MTU               string         `xml:"mtu"`
 	BPDUError         string         `xml:"bpdu-error"`
 	Stats             trafficStat    `xml:"traffic-statistics"`
 	LogicalInterfaces []logInterface `xml:"logical-interface"`
 	InputErrors       struct {
-		Drops  uint64 `xml:"input-drops"`
-		Errors uint64 `xml:"input-errors"`
+		Drops          uint64 `xml:"input-drops"`
+		Errors         uint64 `xml:"input-errors"`
+		FramingErrors  uint64 `xml:"framing-errors"`
+		Runts          uint64 `xml:"input-runts"`
+		Discards       uint64 `xml:"input-discards"`
+		L3Incompletes  uint64 `xml:"input-l3-incompletes"`
+		FIFOErrors     uint64 `xml:"input-fifo-errors"`
+		ResourceErrors uint64 `xml:"input-resource-errors"`
 	} `xml:"input-error-list"`
 	OutputErrors struct {
-		Drops  uint64 `xml:"output-drops"`
-		Errors uint64 `xml:"output-errors"`
+		Drops              uint64 `xml:"output-drops"`
+		Errors             uint64 `xml:"output-errors"`
+		CarrierTransitions uint64 `xml:"carrier-transitions"`
+		Collisions         uint64 `xml:"output-collisions"`
+		FIFOErrors         uint64 `xml:"output-fifo-errors"`
+		ResourceErrors     uint64 `xml:"output-resource-errors"`
+		MTUErrors          uint64 `xml:"mtu-errors"`
+		AgedPackets        uint64 `xml:"aged-packets"`
 	} `xml:"output-error-list"`
 	InterfaceFlapped struct {
 		Seconds uint64 `xml:"seconds,attr"`
@@ -39,6 +52,10 @@ type logInterface struct {
 	Description string         `xml:"description"`
 	Stats       trafficStat    `xml:"traffic-statistics"`
 	LagStats    lagTrafficStat `xml:"lag-traffic-statistics"`
+	ConfigFlags struct {
+		Up   *struct{} `xml:"iff-up"`
+		Down *struct{} `xml:"iff-down"`
+	} `xml:"if-config-flags"`
 }
 
 type trafficStat struct {
@@ -46,6 +63,10 @@ type trafficStat struct {
 	InputPackets  uint64   `xml:"input-packets"`
 	OutputBytes   uint64   `xml:"output-bytes"`
 	OutputPackets uint64   `xml:"output-packets"`
+	InputBPS      uint64   `xml:"input-bps"`
+	InputPPS      uint64   `xml:"input-pps"`
+	OutputBPS     uint64   `xml:"output-bps"`
+	OutputPPS     uint64   `xml:"output-pps"`
 	IPv6Traffic   ipv6Stat `xml:"ipv6-transit-statistics"`
 }
 
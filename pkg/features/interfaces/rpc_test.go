@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+
+package interfaces
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrorLists(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<interface-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-interface">
+    <physical-interface>
+        <name>xe-0/0/0</name>
+        <admin-status>up</admin-status>
+        <oper-status>up</oper-status>
+        <input-error-list>
+            <input-errors>12</input-errors>
+            <input-drops>3</input-drops>
+            <framing-errors>5</framing-errors>
+            <input-runts>1</input-runts>
+            <input-discards>7</input-discards>
+            <input-l3-incompletes>2</input-l3-incompletes>
+            <input-l2-channel-errors>0</input-l2-channel-errors>
+            <input-l2-mismatch-timeouts>0</input-l2-mismatch-timeouts>
+            <input-fifo-errors>4</input-fifo-errors>
+            <input-resource-errors>6</input-resource-errors>
+        </input-error-list>
+        <output-error-list>
+            <carrier-transitions>9</carrier-transitions>
+            <output-errors>8</output-errors>
+            <output-collisions>10</output-collisions>
+            <output-drops>11</output-drops>
+            <aged-packets>13</aged-packets>
+            <mtu-errors>14</mtu-errors>
+            <hs-link-crc-errors>0</hs-link-crc-errors>
+            <output-fifo-errors>15</output-fifo-errors>
+            <output-resource-errors>16</output-resource-errors>
+        </output-error-list>
+    </physical-interface>
+</interface-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.Interfaces), "interface count")
+
+	in := x.Information.Interfaces[0].InputErrors
+	assert.Equal(t, uint64(12), in.Errors, "input-errors")
+	assert.Equal(t, uint64(3), in.Drops, "input-drops")
+	assert.Equal(t, uint64(5), in.FramingErrors, "framing-errors")
+	assert.Equal(t, uint64(1), in.Runts, "input-runts")
+	assert.Equal(t, uint64(7), in.Discards, "input-discards")
+	assert.Equal(t, uint64(2), in.L3Incompletes, "input-l3-incompletes")
+	assert.Equal(t, uint64(4), in.FIFOErrors, "input-fifo-errors")
+	assert.Equal(t, uint64(6), in.ResourceErrors, "input-resource-errors")
+
+	out := x.Information.Interfaces[0].OutputErrors
+	assert.Equal(t, uint64(9), out.CarrierTransitions, "carrier-transitions")
+	assert.Equal(t, uint64(8), out.Errors, "output-errors")
+	assert.Equal(t, uint64(10), out.Collisions, "output-collisions")
+	assert.Equal(t, uint64(11), out.Drops, "output-drops")
+	assert.Equal(t, uint64(13), out.AgedPackets, "aged-packets")
+	assert.Equal(t, uint64(14), out.MTUErrors, "mtu-errors")
+	assert.Equal(t, uint64(15), out.FIFOErrors, "output-fifo-errors")
+	assert.Equal(t, uint64(16), out.ResourceErrors, "output-resource-errors")
+}
+
+func TestParseInterfaceState(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<interface-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-interface">
+    <physical-interface>
+        <name>xe-0/0/0</name>
+        <admin-status>up</admin-status>
+        <oper-status>down</oper-status>
+        <mtu>9192</mtu>
+        <speed>10Gbps</speed>
+        <interface-flapped junos:seconds="1684172206">2023-05-15 17:36:46 UTC (1w0d 01:23 ago)</interface-flapped>
+        <logical-interface>
+            <name>xe-0/0/0.0</name>
+            <if-config-flags>
+                <iff-up/>
+                <iff-snmp-traps/>
+            </if-config-flags>
+        </logical-interface>
+        <logical-interface>
+            <name>xe-0/0/0.100</name>
+            <if-config-flags>
+                <iff-down/>
+                <iff-snmp-traps/>
+            </if-config-flags>
+        </logical-interface>
+    </physical-interface>
+</interface-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	phy := x.Information.Interfaces[0]
+	assert.Equal(t, "9192", phy.MTU, "mtu")
+	assert.Equal(t, uint64(1684172206), phy.InterfaceFlapped.Seconds, "interface-flapped")
+
+	assert.Equal(t, 2, len(phy.LogicalInterfaces), "logical interface count")
+	assert.NotNil(t, phy.LogicalInterfaces[0].ConfigFlags.Up, "iff-up")
+	assert.Nil(t, phy.LogicalInterfaces[0].ConfigFlags.Down, "iff-down")
+	assert.Nil(t, phy.LogicalInterfaces[1].ConfigFlags.Up, "iff-up")
+	assert.NotNil(t, phy.LogicalInterfaces[1].ConfigFlags.Down, "iff-down")
+}
+
+func TestParseTrafficRates(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<interface-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-interface">
+    <physical-interface>
+        <name>xe-0/0/0</name>
+        <traffic-statistics junos:style="verbose">
+            <input-bytes>1730537802</input-bytes>
+            <input-bps>8136</input-bps>
+            <output-bytes>1037272210</output-bytes>
+            <output-bps>4584</output-bps>
+            <input-packets>12468746</input-packets>
+            <input-pps>9</input-pps>
+            <output-packets>8114652</output-packets>
+            <output-pps>5</output-pps>
+        </traffic-statistics>
+    </physical-interface>
+</interface-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	s := x.Information.Interfaces[0].Stats
+	assert.Equal(t, uint64(8136), s.InputBPS, "input-bps")
+	assert.Equal(t, uint64(9), s.InputPPS, "input-pps")
+	assert.Equal(t, uint64(4584), s.OutputBPS, "output-bps")
+	assert.Equal(t, uint64(5), s.OutputPPS, "output-pps")
+}
@@ -5,6 +5,7 @@ package ipsec
 import (
 	"encoding/xml"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/czerwonk/junos_exporter/pkg/collector"
@@ -17,6 +18,11 @@ var (
 	blockState        *prometheus.Desc
 	activeTunnels     *prometheus.Desc
 	configuredTunnels *prometheus.Desc
+	tunnelUp          *prometheus.Desc
+	encryptedBytes    *prometheus.Desc
+	decryptedBytes    *prometheus.Desc
+	encryptedPackets  *prometheus.Desc
+	decryptedPackets  *prometheus.Desc
 )
 
 func init() {
@@ -25,14 +31,22 @@ func init() {
 	blockState = prometheus.NewDesc(prefix+"state", "State of the Security Association", l, nil)
 	activeTunnels = prometheus.NewDesc(prefix+"active_tunnels", "Total active tunnels", l, nil)
 	configuredTunnels = prometheus.NewDesc("junos_ipsec_configured_tunnels", "Total configured tunnels", l, nil)
+
+	tl := []string{"target", "re_name", "tunnel_index", "remote_gateway"}
+	tunnelUp = prometheus.NewDesc("junos_ipsec_tunnel_up", "Security associations of the tunnel are up (1 = up)", tl, nil)
+	encryptedBytes = prometheus.NewDesc("junos_ipsec_tunnel_encrypted_bytes_total", "Number of bytes encrypted (sent) by the tunnel", tl, nil)
+	decryptedBytes = prometheus.NewDesc("junos_ipsec_tunnel_decrypted_bytes_total", "Number of bytes decrypted (received) by the tunnel", tl, nil)
+	encryptedPackets = prometheus.NewDesc("junos_ipsec_tunnel_encrypted_packets_total", "Number of packets encrypted (sent) by the tunnel", tl, nil)
+	decryptedPackets = prometheus.NewDesc("junos_ipsec_tunnel_decrypted_packets_total", "Number of packets decrypted (received) by the tunnel", tl, nil)
 }
 
 type ipsecCollector struct {
+	tunnelStatistics bool
 }
 
-// NewCollector creates a new collector
-func NewCollector() collector.RPCCollector {
-	return &ipsecCollector{}
+// NewCollector creates a new collector, if tunnelStatistics is set the traffic statistics are retrieved for every tunnel (one command per tunnel)
+func NewCollector(tunnelStatistics bool) collector.RPCCollector {
+	return &ipsecCollector{tunnelStatistics: tunnelStatistics}
 }
 
 // Name returns the name of the collector
@@ -45,6 +59,11 @@ func (*ipsecCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- blockState
 	ch <- activeTunnels
 	ch <- configuredTunnels
+	ch <- tunnelUp
+	ch <- encryptedBytes
+	ch <- decryptedBytes
+	ch <- encryptedPackets
+	ch <- decryptedPackets
 }
 
 // Collect collects metrics from JunOS
@@ -63,6 +82,11 @@ func (c *ipsecCollector) Collect(client collector.Client, ch chan<- prometheus.M
 
 		for _, block := range re.IPSec.SecurityAssociations {
 			c.collectForSecurityAssociation(block, ch, append(labelValues, re.Name))
+
+			err = c.collectForTunnel(client, re.Name, block, ch, labelValues)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -91,6 +115,39 @@ func (c *ipsecCollector) collectForSecurityAssociation(block securityAssociation
 	ch <- prometheus.MustNewConstMetric(blockState, prometheus.GaugeValue, float64(stateVal), lp...)
 }
 
+func (c *ipsecCollector) collectForTunnel(client collector.Client, reName string, block securityAssociationBlock, ch chan<- prometheus.Metric, labelValues []string) error {
+	if len(block.SecurityAssociations) == 0 {
+		return nil
+	}
+
+	sa := block.SecurityAssociations[0]
+	index := strconv.FormatInt(sa.TunnelIndex, 10)
+	l := append(labelValues[:len(labelValues):len(labelValues)], reName, index, sa.RemoteGateway)
+	ch <- prometheus.MustNewConstMetric(tunnelUp, prometheus.GaugeValue, float64(stateToInt(&block.State)), l...)
+
+	if !c.tunnelStatistics {
+		return nil
+	}
+
+	var x = statisticsResult{}
+	err := client.RunCommandAndParse("show security ipsec statistics index "+index, &x)
+	if err != nil {
+		return err
+	}
+
+	stats := x.forRoutingEngine(reName)
+	if stats == nil {
+		return nil
+	}
+
+	ch <- prometheus.MustNewConstMetric(encryptedBytes, prometheus.CounterValue, stats.ESP.EncryptedBytes, l...)
+	ch <- prometheus.MustNewConstMetric(decryptedBytes, prometheus.CounterValue, stats.ESP.DecryptedBytes, l...)
+	ch <- prometheus.MustNewConstMetric(encryptedPackets, prometheus.CounterValue, stats.ESP.EncryptedPackets, l...)
+	ch <- prometheus.MustNewConstMetric(decryptedPackets, prometheus.CounterValue, stats.ESP.DecryptedPackets, l...)
+
+	return nil
+}
+
 func stateToInt(state *string) int {
 	retval := 0
 
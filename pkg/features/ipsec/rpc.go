@@ -82,3 +82,37 @@ type configurationSecurityResult struct {
 		} `xml:"security"`
 	} `xml:"configuration"`
 }
+
+type statisticsResult struct {
+	Information statisticsInformation `xml:"usp-ipsec-total-statistics-information"`
+	Results     struct {
+		RoutingEngines []struct {
+			Name        string                `xml:"re-name"`
+			Information statisticsInformation `xml:"usp-ipsec-total-statistics-information"`
+		} `xml:"multi-routing-engine-item"`
+	} `xml:"multi-routing-engine-results"`
+}
+
+type statisticsInformation struct {
+	ESP struct {
+		EncryptedBytes   float64 `xml:"esp-encrypted-bytes"`
+		DecryptedBytes   float64 `xml:"esp-decrypted-bytes"`
+		EncryptedPackets float64 `xml:"esp-encrypted-packets"`
+		DecryptedPackets float64 `xml:"esp-decrypted-packets"`
+	} `xml:"esp-statistics"`
+}
+
+// forRoutingEngine returns the statistics of the routing engine (the single engine result for N/A)
+func (r *statisticsResult) forRoutingEngine(name string) *statisticsInformation {
+	for i, re := range r.Results.RoutingEngines {
+		if re.Name == name {
+			return &r.Results.RoutingEngines[i].Information
+		}
+	}
+
+	if len(r.Results.RoutingEngines) == 0 {
+		return &r.Information
+	}
+
+	return nil
+}
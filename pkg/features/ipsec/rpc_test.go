@@ -411,3 +411,67 @@ func TestParseConfigOutput(t *testing.T) {
 
 	assert.Equal(t, 2, len(f), "configured vpns")
 }
+
+func TestParseTunnelStatistics(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/18.4R3/junos">
+    <usp-ipsec-total-statistics-information>
+        <esp-statistics>
+            <esp-encrypted-bytes>1048576</esp-encrypted-bytes>
+            <esp-decrypted-bytes>2097152</esp-decrypted-bytes>
+            <esp-encrypted-packets>1024</esp-encrypted-packets>
+            <esp-decrypted-packets>2048</esp-decrypted-packets>
+        </esp-statistics>
+        <ah-statistics>
+            <ah-input-bytes>0</ah-input-bytes>
+            <ah-output-bytes>0</ah-output-bytes>
+        </ah-statistics>
+    </usp-ipsec-total-statistics-information>
+</rpc-reply>`
+
+	x := statisticsResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	stats := x.forRoutingEngine("N/A")
+	assert.NotNil(t, stats)
+	assert.Equal(t, float64(1048576), stats.ESP.EncryptedBytes, "encrypted bytes")
+	assert.Equal(t, float64(2097152), stats.ESP.DecryptedBytes, "decrypted bytes")
+	assert.Equal(t, float64(1024), stats.ESP.EncryptedPackets, "encrypted packets")
+	assert.Equal(t, float64(2048), stats.ESP.DecryptedPackets, "decrypted packets")
+}
+
+func TestParseTunnelStatisticsMultiRE(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/18.4R3/junos">
+    <multi-routing-engine-results>
+        <multi-routing-engine-item>
+            <re-name>node0</re-name>
+            <usp-ipsec-total-statistics-information>
+                <esp-statistics>
+                    <esp-encrypted-bytes>100</esp-encrypted-bytes>
+                    <esp-decrypted-bytes>200</esp-decrypted-bytes>
+                </esp-statistics>
+            </usp-ipsec-total-statistics-information>
+        </multi-routing-engine-item>
+        <multi-routing-engine-item>
+            <re-name>node1</re-name>
+            <usp-ipsec-total-statistics-information>
+                <esp-statistics>
+                    <esp-encrypted-bytes>0</esp-encrypted-bytes>
+                    <esp-decrypted-bytes>0</esp-decrypted-bytes>
+                </esp-statistics>
+            </usp-ipsec-total-statistics-information>
+        </multi-routing-engine-item>
+    </multi-routing-engine-results>
+</rpc-reply>`
+
+	x := statisticsResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	stats := x.forRoutingEngine("node0")
+	assert.NotNil(t, stats)
+	assert.Equal(t, float64(100), stats.ESP.EncryptedBytes, "encrypted bytes")
+	assert.Equal(t, float64(200), stats.ESP.DecryptedBytes, "decrypted bytes")
+
+	assert.Nil(t, x.forRoutingEngine("node2"), "unknown routing engine")
+}
@@ -5,5 +5,23 @@ package isis
 type adjacencies struct {
 	Up          float64
 	Total       float64
+	Levels      map[int64]*levelCount
 	Adjacencies []adjacency
 }
+
+type levelCount struct {
+	Up    float64
+	Total float64
+}
+
+// downTransitions returns the number of transitions to state down in the adjacency transition log
+func (a *adjacency) downTransitions() float64 {
+	c := 0
+	for _, l := range a.TransitionLog {
+		if l.State == "Down" {
+			c++
+		}
+	}
+
+	return float64(c)
+}
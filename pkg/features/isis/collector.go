@@ -15,14 +15,22 @@ var (
 	upCount    *prometheus.Desc
 	totalCount *prometheus.Desc
 	adjState   *prometheus.Desc
+	adjFlaps   *prometheus.Desc
+	levelUp    *prometheus.Desc
+	levelTotal *prometheus.Desc
+	lspCount   *prometheus.Desc
 )
 
 func init() {
 	l := []string{"target"}
 	upCount = prometheus.NewDesc(prefix+"up_count", "Number of ISIS Adjacencies in state up", l, nil)
 	totalCount = prometheus.NewDesc(prefix+"total_count", "Number of ISIS Adjacencies", l, nil)
+	levelUp = prometheus.NewDesc(prefix+"level_up_count", "Number of ISIS Adjacencies in state up per level", []string{"target", "level"}, nil)
+	levelTotal = prometheus.NewDesc(prefix+"level_total_count", "Number of ISIS Adjacencies per level", []string{"target", "level"}, nil)
+	lspCount = prometheus.NewDesc(prefix+"lsp_count", "Number of LSPs in the ISIS database", []string{"target", "level"}, nil)
 	l = append(l, "interface_name", "sysem_name", "level")
 	adjState = prometheus.NewDesc(prefix+"adjacency_state", "The ISIS Adjacency state (0 = DOWN, 1 = UP, 2 = NEW, 3 = ONE-WAY, 4 =INITIALIZING , 5 = REJECTED)", l, nil)
+	adjFlaps = prometheus.NewDesc(prefix+"adjacency_flaps", "Number of transitions to state down in the ISIS Adjacency transition log", l, nil)
 }
 
 type isisCollector struct {
@@ -42,6 +50,11 @@ func (*isisCollector) Name() string {
 func (*isisCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- upCount
 	ch <- totalCount
+	ch <- levelUp
+	ch <- levelTotal
+	ch <- lspCount
+	ch <- adjState
+	ch <- adjFlaps
 }
 
 // Collect collects metrics from JunOS
@@ -54,9 +67,15 @@ func (c *isisCollector) Collect(client collector.Client, ch chan<- prometheus.Me
 	ch <- prometheus.MustNewConstMetric(upCount, prometheus.GaugeValue, adjancies.Up, labelValues...)
 	ch <- prometheus.MustNewConstMetric(totalCount, prometheus.GaugeValue, adjancies.Total, labelValues...)
 
+	for level, cnt := range adjancies.Levels {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strconv.Itoa(int(level)))
+		ch <- prometheus.MustNewConstMetric(levelUp, prometheus.GaugeValue, cnt.Up, l...)
+		ch <- prometheus.MustNewConstMetric(levelTotal, prometheus.GaugeValue, cnt.Total, l...)
+	}
+
 	if adjancies.Adjacencies != nil {
 		for _, adj := range adjancies.Adjacencies {
-			localLabelvalues := append(labelValues, adj.InterfaceName, adj.SystemName, strconv.Itoa(int(adj.Level)))
+			localLabelvalues := append(labelValues[:len(labelValues):len(labelValues)], adj.InterfaceName, adj.SystemName, strconv.Itoa(int(adj.Level)))
 			state := 0.0
 			switch adj.AdjacencyState {
 			case "Down":
@@ -74,28 +93,58 @@ func (c *isisCollector) Collect(client collector.Client, ch chan<- prometheus.Me
 			}
 
 			ch <- prometheus.MustNewConstMetric(adjState, prometheus.GaugeValue, state, localLabelvalues...)
+			ch <- prometheus.MustNewConstMetric(adjFlaps, prometheus.GaugeValue, adj.downTransitions(), localLabelvalues...)
 		}
 	}
 
+	return c.collectDatabase(client, ch, labelValues)
+}
+
+func (c *isisCollector) collectDatabase(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = databaseResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show isis database", client), &x)
+	if err != nil {
+		return err
+	}
+
+	for _, db := range x.Information.Databases {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strconv.Itoa(int(db.Level)))
+		ch <- prometheus.MustNewConstMetric(lspCount, prometheus.GaugeValue, float64(db.LSPCount), l...)
+	}
+
 	return nil
 }
 
 func (c *isisCollector) isisAdjancies(client collector.Client) (*adjacencies, error) {
-	up := 0
-	total := 0
-
 	var x = result{}
-	err := client.RunCommandAndParse("show isis adjacency", &x)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show isis adjacency extensive", client), &x)
 	if err != nil {
 		return nil, err
 	}
+	collector.ReportRecords(client, len(x.Information.Adjacencies))
+
+	return adjacenciesFromResult(&x), nil
+}
+
+func adjacenciesFromResult(x *result) *adjacencies {
+	up := 0
+	total := 0
+	levels := make(map[int64]*levelCount)
 
 	for _, adjacency := range x.Information.Adjacencies {
+		lc, found := levels[adjacency.Level]
+		if !found {
+			lc = &levelCount{}
+			levels[adjacency.Level] = lc
+		}
+
 		if adjacency.AdjacencyState == "Up" {
 			up++
+			lc.Up++
 		}
 		total++
+		lc.Total++
 	}
 
-	return &adjacencies{Up: float64(up), Total: float64(total), Adjacencies: x.Information.Adjacencies}, nil
+	return &adjacencies{Up: float64(up), Total: float64(total), Levels: levels, Adjacencies: x.Information.Adjacencies}
 }
@@ -9,10 +9,29 @@ type result struct {
 }
 
 type adjacency struct {
-	InterfaceName  string `xml:"interface-name"`
-	SystemName     string `xml:"system-name"`
-	Level          int64  `xml:"level"`
-	AdjacencyState string `xml:"adjacency-state"`
-	Holdtime       int64  `xml:"holdtime"`
-	SNPA           string `xml:"snpa"`
+	InterfaceName  string             `xml:"interface-name"`
+	SystemName     string             `xml:"system-name"`
+	Level          int64              `xml:"level"`
+	AdjacencyState string             `xml:"adjacency-state"`
+	Holdtime       int64              `xml:"holdtime"`
+	SNPA           string             `xml:"snpa"`
+	TransitionLog  []adjacencyLogItem `xml:"isis-adjacency-log"`
+}
+
+type adjacencyLogItem struct {
+	When   string `xml:"adjacency-when"`
+	State  string `xml:"adjacency-state"`
+	Event  string `xml:"adjacency-event"`
+	Reason string `xml:"adjacency-down-reason"`
+}
+
+type databaseResult struct {
+	Information struct {
+		Databases []database `xml:"isis-database"`
+	} `xml:"isis-database-information"`
+}
+
+type database struct {
+	Level    int64 `xml:"level"`
+	LSPCount int64 `xml:"lsp-count"`
 }
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+
+package isis
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAdjacencyExtensive(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <isis-adjacency-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing" junos:style="extensive">
+        <isis-adjacency>
+            <system-name>router2</system-name>
+            <interface-name>ae0.0</interface-name>
+            <level>2</level>
+            <adjacency-state>Up</adjacency-state>
+            <holdtime>24</holdtime>
+            <isis-adjacency-log>
+                <adjacency-when>Mon Oct  2 10:00:00</adjacency-when>
+                <adjacency-state>Up</adjacency-state>
+                <adjacency-event>Seenself</adjacency-event>
+            </isis-adjacency-log>
+            <isis-adjacency-log>
+                <adjacency-when>Mon Oct  2 09:59:00</adjacency-when>
+                <adjacency-state>Down</adjacency-state>
+                <adjacency-event>Error</adjacency-event>
+                <adjacency-down-reason>Interface Down</adjacency-down-reason>
+            </isis-adjacency-log>
+        </isis-adjacency>
+        <isis-adjacency>
+            <system-name>router3</system-name>
+            <interface-name>ae1.0</interface-name>
+            <level>2</level>
+            <adjacency-state>Initializing</adjacency-state>
+            <holdtime>7</holdtime>
+        </isis-adjacency>
+        <isis-adjacency>
+            <system-name>router4</system-name>
+            <interface-name>ae2.0</interface-name>
+            <level>1</level>
+            <adjacency-state>Up</adjacency-state>
+            <holdtime>21</holdtime>
+        </isis-adjacency>
+    </isis-adjacency-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	adj := adjacenciesFromResult(&x)
+	assert.Equal(t, float64(2), adj.Up)
+	assert.Equal(t, float64(3), adj.Total)
+	assert.Equal(t, &levelCount{Up: 1, Total: 2}, adj.Levels[2])
+	assert.Equal(t, &levelCount{Up: 1, Total: 1}, adj.Levels[1])
+	assert.Equal(t, float64(1), adj.Adjacencies[0].downTransitions())
+	assert.Equal(t, "Interface Down", adj.Adjacencies[0].TransitionLog[1].Reason)
+	assert.Equal(t, float64(0), adj.Adjacencies[1].downTransitions())
+}
+
+func TestParseDatabase(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <isis-database-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing" junos:style="brief">
+        <isis-database>
+            <level>1</level>
+            <isis-database-entry>
+                <lsp-id>router1.00-00</lsp-id>
+            </isis-database-entry>
+            <lsp-count>1</lsp-count>
+        </isis-database>
+        <isis-database>
+            <level>2</level>
+            <isis-database-entry>
+                <lsp-id>router1.00-00</lsp-id>
+            </isis-database-entry>
+            <isis-database-entry>
+                <lsp-id>router2.00-00</lsp-id>
+            </isis-database-entry>
+            <lsp-count>2</lsp-count>
+        </isis-database>
+    </isis-database-information>
+</rpc-reply>`
+
+	var x databaseResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []database{{Level: 1, LSPCount: 1}, {Level: 2, LSPCount: 2}}, x.Information.Databases)
+}
@@ -87,6 +87,7 @@ func (c *l2circuitCollector) Collect(client collector.Client, ch chan<- promethe
 		connCount += +len(neighbors[i].Connections)
 	}
 
+	collector.ReportRecords(client, connCount)
 	for _, a := range neighbors {
 		l := append(labelValues, a.Address)
 		for _, conn := range a.Connections {
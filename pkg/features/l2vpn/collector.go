@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+
+package l2vpn
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_l2vpn_"
+
+var (
+	connectionStateDesc         *prometheus.Desc
+	connectionUpTransitionsDesc *prometheus.Desc
+	connectionStateMap          = map[string]int{
+		"Dn":    0,
+		"Up":    1,
+		"EI":    2,
+		"EM":    3,
+		"VC-Dn": 4,
+		"CM":    5,
+		"CN":    6,
+		"OR":    7,
+		"OL":    8,
+		"LD":    9,
+		"RD":    10,
+		"LN":    11,
+		"RN":    12,
+		"XX":    13,
+		"MM":    14,
+		"BK":    15,
+		"PF":    16,
+		"RS":    17,
+		"LB":    18,
+		"VM":    19,
+		"NC":    20,
+		"WE":    21,
+		"NP":    22,
+		"CF":    23,
+		"SC":    24,
+		"LM":    25,
+		"RM":    26,
+		"IL":    27,
+		"MI":    28,
+		"ST":    29,
+		"PB":    30,
+		"SN":    31,
+		"RB":    32,
+		"HS":    33,
+	}
+)
+
+func init() {
+	l := []string{"target", "type", "instance", "local_site", "remote_site"}
+	stateDescription := "State of the L2VPN/VPLS connection (Dn: 0, Up: 1, EI: 2, EM: 3, VC-Dn: 4, CM: 5, CN: 6, OR: 7, OL: 8, LD: 9, RD: 10, LN: 11, RN: 12, XX: 13, MM: 14, BK: 15, PF: 16, RS: 17, LB: 18, VM: 19, NC: 20, WE: 21, NP: 22, CF: 23, SC: 24, LM: 25, RM: 26, IL: 27, MI: 28, ST: 29, PB: 30, SN: 31, RB: 32, HS: 33)"
+	connectionStateDesc = prometheus.NewDesc(prefix+"connection_state", stateDescription, l, nil)
+	connectionUpTransitionsDesc = prometheus.NewDesc(prefix+"connection_up_transitions", "Number of times the L2VPN/VPLS connection went up", l, nil)
+}
+
+type l2vpnCollector struct {
+}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &l2vpnCollector{}
+}
+
+// Name returns the name of the collector
+func (*l2vpnCollector) Name() string {
+	return "L2VPN"
+}
+
+// Describe describes the metrics
+func (*l2vpnCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectionStateDesc
+	ch <- connectionUpTransitionsDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *l2vpnCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	cmds := []struct {
+		connectionType string
+		cmd            string
+	}{
+		{connectionType: "l2vpn", cmd: "show l2vpn connections"},
+		{connectionType: "vpls", cmd: "show vpls connections"},
+	}
+
+	for _, t := range cmds {
+		var x = result{}
+		err := client.RunCommandAndParse(t.cmd, &x)
+		if err != nil {
+			return err
+		}
+
+		collector.ReportRecords(client, len(x.Information.Instances))
+		c.collectForResult(&x, t.connectionType, ch, labelValues)
+	}
+
+	return nil
+}
+
+func (c *l2vpnCollector) collectForResult(x *result, connectionType string, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, inst := range x.Information.Instances {
+		for _, site := range inst.Sites {
+			for _, conn := range site.Connections {
+				l := append(labelValues[:len(labelValues):len(labelValues)], connectionType, inst.Name, site.LocalSite, conn.RemoteSite)
+				ch <- prometheus.MustNewConstMetric(connectionStateDesc, prometheus.GaugeValue, float64(connectionState(conn.Status)), l...)
+				ch <- prometheus.MustNewConstMetric(connectionUpTransitionsDesc, prometheus.GaugeValue, float64(conn.UpTransitions), l...)
+			}
+		}
+	}
+}
+
+// connectionState maps the status code of a connection, unknown codes are reported as XX (unknown)
+func connectionState(status string) int {
+	if s, found := connectionStateMap[status]; found {
+		return s
+	}
+
+	return connectionStateMap["XX"]
+}
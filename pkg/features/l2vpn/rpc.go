@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+
+package l2vpn
+
+type result struct {
+	Information struct {
+		Instances []instance `xml:"instance"`
+	} `xml:"l2vpn-connection-information"`
+}
+
+type instance struct {
+	Name  string          `xml:"instance-name"`
+	Sites []referenceSite `xml:"reference-site"`
+}
+
+type referenceSite struct {
+	LocalSite   string       `xml:"local-site-id"`
+	Connections []connection `xml:"connection"`
+}
+
+type connection struct {
+	RemoteSite    string `xml:"connection-id"`
+	Type          string `xml:"connection-type"`
+	Status        string `xml:"connection-status"`
+	UpTransitions int64  `xml:"up-transitions"`
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+
+package l2vpn
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConnections(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <l2vpn-connection-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-l2vpn">
+        <instance>
+            <instance-name>customer-a</instance-name>
+            <edge-protection>Not-Primary</edge-protection>
+            <reference-site>
+                <local-site-id>pe1 (1)</local-site-id>
+                <connection>
+                    <connection-id>2</connection-id>
+                    <connection-type>rmt</connection-type>
+                    <connection-status>Up</connection-status>
+                    <last-change>Oct  1 10:00:00 2026</last-change>
+                    <up-transitions>3</up-transitions>
+                </connection>
+                <connection>
+                    <connection-id>3</connection-id>
+                    <connection-type>rmt</connection-type>
+                    <connection-status>RD</connection-status>
+                </connection>
+            </reference-site>
+        </instance>
+    </l2vpn-connection-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Len(t, x.Information.Instances, 1)
+	inst := x.Information.Instances[0]
+	assert.Equal(t, "customer-a", inst.Name)
+	assert.Equal(t, "pe1 (1)", inst.Sites[0].LocalSite)
+
+	conns := inst.Sites[0].Connections
+	assert.Equal(t, connection{RemoteSite: "2", Type: "rmt", Status: "Up", UpTransitions: 3}, conns[0])
+	assert.Equal(t, 1, connectionState(conns[0].Status))
+	assert.Equal(t, 10, connectionState(conns[1].Status))
+	assert.Equal(t, 13, connectionState("??"))
+}
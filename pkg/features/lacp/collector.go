@@ -3,6 +3,8 @@
 package lacp
 
 import (
+	"strings"
+
 	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -10,8 +12,12 @@ import (
 const prefix = "junos_lacp_"
 
 var (
-	lacpMuxState    *prometheus.Desc
-	lacpMuxStateMap = map[string]int{
+	lacpMuxState     *prometheus.Desc
+	lacpReceiveState *prometheus.Desc
+	lacpCollecting   *prometheus.Desc
+	lacpDistributing *prometheus.Desc
+	lacpSynchronized *prometheus.Desc
+	lacpMuxStateMap  = map[string]int{
 		"Detached":                1,
 		"Waiting":                 2,
 		"Attached":                3,
@@ -19,11 +25,25 @@ var (
 		"Distributing":            5,
 		"Collecting distributing": 6,
 	}
+	lacpReceiveStateMap = map[string]int{
+		"Current":       1,
+		"Expired":       2,
+		"Defaulted":     3,
+		"Initialize":    4,
+		"Port disabled": 5,
+		"LACP disabled": 6,
+	}
 )
 
 func init() {
 	l := []string{"target", "aggregate", "name"}
-	lacpMuxState = prometheus.NewDesc(prefix+"muxstate", "lacp mux state (1: detached, 2: waiting, 3: attached, 4: collecting, 5: distributing, 6: collecting distribuging)", l, nil)
+	lacpMuxState = prometheus.NewDesc(prefix+"muxstate", "lacp mux state (1: detached, 2: waiting, 3: attached, 4: collecting, 5: distributing, 6: collecting distributing)", l, nil)
+	lacpReceiveState = prometheus.NewDesc(prefix+"receive_state", "lacp receive state (1: current, 2: expired, 3: defaulted, 4: initialize, 5: port disabled, 6: lacp disabled)", l, nil)
+
+	l = append(l, "role")
+	lacpCollecting = prometheus.NewDesc(prefix+"collecting", "Member is collecting (1 = yes)", l, nil)
+	lacpDistributing = prometheus.NewDesc(prefix+"distributing", "Member is distributing (1 = yes)", l, nil)
+	lacpSynchronized = prometheus.NewDesc(prefix+"synchronized", "Member is synchronized (1 = yes)", l, nil)
 }
 
 type lacpCollector struct {
@@ -42,6 +62,10 @@ func NewCollector() collector.RPCCollector {
 // Describe describes the metrics
 func (*lacpCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- lacpMuxState
+	ch <- lacpReceiveState
+	ch <- lacpCollecting
+	ch <- lacpDistributing
+	ch <- lacpSynchronized
 }
 
 // Collect collects metrics from JunOS
@@ -52,12 +76,29 @@ func (c *lacpCollector) Collect(client collector.Client, ch chan<- prometheus.Me
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.LacpInterfaces))
 	for _, iface := range x.Information.LacpInterfaces {
 		for _, member := range iface.LagLACPProtocols {
 			l := append(labelValues, iface.LagLACPHeader.Name, member.Member)
 			ch <- prometheus.MustNewConstMetric(lacpMuxState, prometheus.GaugeValue, float64(lacpMuxStateMap[member.LacpMuxState]), l...)
+			ch <- prometheus.MustNewConstMetric(lacpReceiveState, prometheus.GaugeValue, float64(lacpReceiveStateMap[member.LacpReceiveState]), l...)
+		}
+
+		for _, state := range iface.LagLACPStates {
+			l := append(labelValues, iface.LagLACPHeader.Name, state.Member, strings.ToLower(state.Role))
+			ch <- prometheus.MustNewConstMetric(lacpCollecting, prometheus.GaugeValue, yesToFloat(state.Collecting), l...)
+			ch <- prometheus.MustNewConstMetric(lacpDistributing, prometheus.GaugeValue, yesToFloat(state.Distributing), l...)
+			ch <- prometheus.MustNewConstMetric(lacpSynchronized, prometheus.GaugeValue, yesToFloat(state.Synchronization), l...)
 		}
 	}
 
 	return nil
 }
+
+func yesToFloat(s string) float64 {
+	if strings.TrimSpace(s) == "Yes" {
+		return 1
+	}
+
+	return 0
+}
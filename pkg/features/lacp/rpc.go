@@ -12,10 +12,20 @@ type lacpInterface struct {
 	LagLACPHeader struct {
 		Name string `xml:"aggregate-name"`
 	} `xml:"lag-lacp-header"`
+	LagLACPStates    []lagLACPState    `xml:"lag-lacp-state"`
 	LagLACPProtocols []lagLACPProtocol `xml:"lag-lacp-protocol"`
 }
 
+type lagLACPState struct {
+	Member          string `xml:"name"`
+	Role            string `xml:"lacp-role"`
+	Collecting      string `xml:"lacp-collecting"`
+	Distributing    string `xml:"lacp-distributing"`
+	Synchronization string `xml:"lacp-synchronization"`
+}
+
 type lagLACPProtocol struct {
-	Member       string `xml:"name"`
-	LacpMuxState string `xml:"lacp-mux-state"`
+	Member           string `xml:"name"`
+	LacpReceiveState string `xml:"lacp-receive-state"`
+	LacpMuxState     string `xml:"lacp-mux-state"`
 }
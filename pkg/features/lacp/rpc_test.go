@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+
+package lacp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLACPInterfaces(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.2R3/junos">
+		<lacp-interface-information-list xmlns="http://xml.juniper.net/junos/20.2R3/junos-lacpd">
+			<lacp-interface-information>
+				<lag-lacp-header>
+					<aggregate-name>ae0</aggregate-name>
+				</lag-lacp-header>
+				<lag-lacp-state>
+					<name>xe-0/0/0</name>
+					<lacp-role>Actor</lacp-role>
+					<lacp-expired>No</lacp-expired>
+					<lacp-defaulted>No</lacp-defaulted>
+					<lacp-distributing>Yes</lacp-distributing>
+					<lacp-collecting>Yes</lacp-collecting>
+					<lacp-synchronization>Yes</lacp-synchronization>
+				</lag-lacp-state>
+				<lag-lacp-state>
+					<name>xe-0/0/1</name>
+					<lacp-role>Actor</lacp-role>
+					<lacp-distributing>No</lacp-distributing>
+					<lacp-collecting>No</lacp-collecting>
+					<lacp-synchronization>No</lacp-synchronization>
+				</lag-lacp-state>
+				<lag-lacp-protocol>
+					<name>xe-0/0/0</name>
+					<lacp-receive-state>Current</lacp-receive-state>
+					<lacp-transmit-state>Fast periodic</lacp-transmit-state>
+					<lacp-mux-state>Collecting distributing</lacp-mux-state>
+				</lag-lacp-protocol>
+				<lag-lacp-protocol>
+					<name>xe-0/0/1</name>
+					<lacp-receive-state>Port disabled</lacp-receive-state>
+					<lacp-transmit-state>No periodic</lacp-transmit-state>
+					<lacp-mux-state>Detached</lacp-mux-state>
+				</lag-lacp-protocol>
+			</lacp-interface-information>
+		</lacp-interface-information-list>
+	</rpc-reply>`
+
+	rpc := result{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iface := rpc.Information.LacpInterfaces[0]
+	assert.Equal(t, "ae0", iface.LagLACPHeader.Name, "aggregate-name")
+	assert.Equal(t, 2, len(iface.LagLACPStates), "states")
+	assert.Equal(t, 2, len(iface.LagLACPProtocols), "protocols")
+
+	assert.Equal(t, float64(1), yesToFloat(iface.LagLACPStates[0].Collecting), "collecting")
+	assert.Equal(t, float64(0), yesToFloat(iface.LagLACPStates[1].Distributing), "distributing")
+
+	assert.Equal(t, 6, lacpMuxStateMap[iface.LagLACPProtocols[0].LacpMuxState], "mux state")
+	assert.Equal(t, 1, lacpMuxStateMap[iface.LagLACPProtocols[1].LacpMuxState], "mux state detached")
+	assert.Equal(t, 5, lacpReceiveStateMap[iface.LagLACPProtocols[1].LacpReceiveState], "receive state")
+}
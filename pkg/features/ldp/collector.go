@@ -4,6 +4,7 @@ package ldp
 
 import (
 	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/parse"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -11,9 +12,12 @@ var (
 	ldpNeighborDesc     *prometheus.Desc
 	ldpSessionDesc      *prometheus.Desc
 	ldpSessionCountDesc *prometheus.Desc
+	ldpSessionUptime    *prometheus.Desc
+	ldpSessionInfo      *prometheus.Desc
+	ldpNeighborHoldTime *prometheus.Desc
 	ldpStateMap         = map[string]int{
 		"Operational": 1,
-		"Nonexistant": 0,
+		"Nonexistent": 0,
 	}
 )
 
@@ -27,7 +31,11 @@ func init() {
 
 	ldpSessionCountDesc = prometheus.NewDesc(ldprefix+"session_count", "Number of LDP Sessions", l, nil)
 
-	ldpSessionDesc = prometheus.NewDesc(ldprefix+"session_state", "State of LDP Sessions", lSession, nil)
+	ldpSessionDesc = prometheus.NewDesc(ldprefix+"session_state", "State of LDP Sessions (1 = Operational, 0 = Nonexistent or any other state)", lSession, nil)
+	ldpSessionUptime = prometheus.NewDesc(ldprefix+"session_uptime_seconds", "Uptime of the LDP session in seconds", lSession, nil)
+	ldpSessionInfo = prometheus.NewDesc(ldprefix+"session_info", "Information about the LDP session (always 1)", append(lSession, "label_space", "connection_state"), nil)
+
+	ldpNeighborHoldTime = prometheus.NewDesc(ldprefix+"neighbor_hold_time_remaining_seconds", "Remaining hold time of the LDP hello adjacency in seconds", []string{"target", "neighbor", "interface", "label_space"}, nil)
 }
 
 // Collector collects ldpv3 metrics
@@ -49,6 +57,9 @@ func (*ldpCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- ldpNeighborDesc
 	ch <- ldpSessionCountDesc
 	ch <- ldpSessionDesc
+	ch <- ldpSessionUptime
+	ch <- ldpSessionInfo
+	ch <- ldpNeighborHoldTime
 }
 
 // Collect collects metrics from JunOS
@@ -63,7 +74,7 @@ func (c *ldpCollector) Collect(client collector.Client, ch chan<- prometheus.Met
 
 func (c *ldpCollector) collectLDPMetrics(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	var x = result{}
-	err := client.RunCommandAndParse("show ldp neighbor", &x)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show ldp neighbor", client), &x)
 	if err != nil {
 		return err
 	}
@@ -71,22 +82,33 @@ func (c *ldpCollector) collectLDPMetrics(client collector.Client, ch chan<- prom
 	neighbors := x.Information.Neighbors
 	ch <- prometheus.MustNewConstMetric(ldpNeighborDesc, prometheus.GaugeValue, float64(len(neighbors)), labelValues...)
 
+	for _, n := range neighbors {
+		l := append(labelValues[:len(labelValues):len(labelValues)], n.Address, n.Interface, n.LabelSpaceID)
+		ch <- prometheus.MustNewConstMetric(ldpNeighborHoldTime, prometheus.GaugeValue, float64(n.RemainingTime), l...)
+	}
+
 	return nil
 }
 
 func (c *ldpCollector) collectLDPSessions(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	var x = sessionResult{}
-	err := client.RunCommandAndParse("show ldp session", &x)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show ldp session detail", client), &x)
 	if err != nil {
 		return err
 	}
 
 	sessions := x.Information.Sessions
 	sessionCount := len(sessions)
+	collector.ReportRecords(client, sessionCount)
 
 	for _, sess := range sessions {
-		l := append(labelValues, sess.NeighborAddress)
+		l := append(labelValues[:len(labelValues):len(labelValues)], sess.NeighborAddress)
 		ch <- prometheus.MustNewConstMetric(ldpSessionDesc, prometheus.GaugeValue, float64(ldpStateMap[sess.State]), l...)
+		ch <- prometheus.MustNewConstMetric(ldpSessionInfo, prometheus.GaugeValue, 1, append(l, sess.remoteLabelSpace(), sess.ConnectionState)...)
+
+		if uptime, err := parse.Uptime(sess.Uptime); err == nil {
+			ch <- prometheus.MustNewConstMetric(ldpSessionUptime, prometheus.GaugeValue, uptime, l...)
+		}
 	}
 	ch <- prometheus.MustNewConstMetric(ldpSessionCountDesc, prometheus.GaugeValue, float64(sessionCount), labelValues...)
 
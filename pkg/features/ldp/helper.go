@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: MIT
+
+package ldp
+
+import "strings"
+
+// remoteLabelSpace returns the label space of the peer from the session ID (e.g. 192.0.2.1:0--192.0.2.2:0)
+func (s *ldpSession) remoteLabelSpace() string {
+	parts := strings.Split(s.SessionID, "--")
+	if len(parts) != 2 {
+		return ""
+	}
+
+	return parts[1]
+}
@@ -11,7 +11,10 @@ type ldpInformation struct {
 }
 
 type ldpNeighbor struct {
-	Address string `xml:"ldp-neighbor-address"`
+	Address       string `xml:"ldp-neighbor-address"`
+	Interface     string `xml:"interface-name"`
+	LabelSpaceID  string `xml:"ldp-label-space-id"`
+	RemainingTime int64  `xml:"ldp-remaining-time"`
 }
 
 type sessionResult struct {
@@ -24,5 +27,8 @@ type ldpSessionInformation struct {
 
 type ldpSession struct {
 	NeighborAddress string `xml:"ldp-neighbor-address"`
-	State           string `xml:"ldp-connection-state"`
+	State           string `xml:"ldp-session-state"`
+	ConnectionState string `xml:"ldp-connection-state"`
+	SessionID       string `xml:"ldp-session-id"`
+	Uptime          string `xml:"ldp-up-time"`
 }
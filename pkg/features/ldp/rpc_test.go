@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+package ldp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/parse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSessionDetail(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <ldp-session-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing">
+        <ldp-session junos:style="detail">
+            <ldp-neighbor-address>192.0.2.2</ldp-neighbor-address>
+            <ldp-session-state>Operational</ldp-session-state>
+            <ldp-connection-state>Open</ldp-connection-state>
+            <ldp-remaining-time>26</ldp-remaining-time>
+            <ldp-session-adv-mode>DU</ldp-session-adv-mode>
+            <ldp-session-id>192.0.2.1:0--192.0.2.2:0</ldp-session-id>
+            <ldp-up-time>1w2d 03:04:05</ldp-up-time>
+        </ldp-session>
+        <ldp-session junos:style="detail">
+            <ldp-neighbor-address>192.0.2.3</ldp-neighbor-address>
+            <ldp-session-state>Nonexistent</ldp-session-state>
+            <ldp-connection-state>Closed</ldp-connection-state>
+        </ldp-session>
+    </ldp-session-information>
+</rpc-reply>`
+
+	var x sessionResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	sessions := x.Information.Sessions
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, 1, ldpStateMap[sessions[0].State])
+	assert.Equal(t, "192.0.2.2:0", sessions[0].remoteLabelSpace())
+	assert.Equal(t, "Open", sessions[0].ConnectionState)
+
+	uptime, err := parse.Uptime(sessions[0].Uptime)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(9*86400+3*3600+4*60+5), uptime)
+
+	assert.Equal(t, 0, ldpStateMap[sessions[1].State])
+	assert.Equal(t, "", sessions[1].remoteLabelSpace())
+
+	_, err = parse.Uptime(sessions[1].Uptime)
+	assert.Error(t, err)
+}
+
+func TestParseNeighbor(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <ldp-neighbor-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing">
+        <ldp-neighbor>
+            <ldp-neighbor-address>10.0.0.1</ldp-neighbor-address>
+            <interface-name>ae0.0</interface-name>
+            <ldp-label-space-id>192.0.2.2:0</ldp-label-space-id>
+            <ldp-remaining-time>12</ldp-remaining-time>
+        </ldp-neighbor>
+    </ldp-neighbor-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []ldpNeighbor{{Address: "10.0.0.1", Interface: "ae0.0", LabelSpaceID: "192.0.2.2:0", RemainingTime: 12}}, x.Information.Neighbors)
+}
@@ -3,6 +3,8 @@
 package mac
 
 import (
+	"strings"
+
 	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -14,6 +16,7 @@ var (
 	recieveCount *prometheus.Desc
 	dynamicCount *prometheus.Desc
 	floodCount   *prometheus.Desc
+	vlanCount    *prometheus.Desc
 )
 
 func init() {
@@ -22,9 +25,11 @@ func init() {
 	recieveCount = prometheus.NewDesc(prefix+"recieve_count", "Number of L3 recieve route entries in table", l, nil)
 	dynamicCount = prometheus.NewDesc(prefix+"dynamic_count", "Number of dynamic entries in table", l, nil)
 	floodCount = prometheus.NewDesc(prefix+"flood_count", "Number of flood entries in table", l, nil)
+	vlanCount = prometheus.NewDesc(prefix+"vlan_count", "Number of entries in table per VLAN", []string{"target", "instance", "vlan"}, nil)
 }
 
 type macCollector struct {
+	vlanCounts bool
 }
 
 // Name returns the name of the collector
@@ -32,9 +37,9 @@ func (*macCollector) Name() string {
 	return "Mac"
 }
 
-// NewCollector creates a new collector
-func NewCollector() collector.RPCCollector {
-	return &macCollector{}
+// NewCollector creates a new collector, if vlanCounts is set the entries of the table are counted per VLAN
+func NewCollector(vlanCounts bool) collector.RPCCollector {
+	return &macCollector{vlanCounts: vlanCounts}
 }
 
 // Describe describes the metrics
@@ -43,6 +48,7 @@ func (*macCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- recieveCount
 	ch <- dynamicCount
 	ch <- floodCount
+	ch <- vlanCount
 }
 
 // Collect collects metrics from JunOS
@@ -59,5 +65,43 @@ func (c *macCollector) Collect(client collector.Client, ch chan<- prometheus.Met
 	ch <- prometheus.MustNewConstMetric(dynamicCount, prometheus.GaugeValue, float64(entry.DynamicCount), labelValues...)
 	ch <- prometheus.MustNewConstMetric(floodCount, prometheus.GaugeValue, float64(entry.FloodCount), labelValues...)
 
+	if c.vlanCounts {
+		return c.collectVLANCounts(client, ch, labelValues)
+	}
+
 	return nil
 }
+
+func (c *macCollector) collectVLANCounts(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = macTableResult{}
+	err := client.RunCommandAndParse("show ethernet-switching table brief", &x)
+	if err != nil {
+		return err
+	}
+
+	for ri, counts := range vlanCounts(&x) {
+		for vlan, count := range counts {
+			l := append(labelValues[:len(labelValues):len(labelValues)], ri, vlan)
+			ch <- prometheus.MustNewConstMetric(vlanCount, prometheus.GaugeValue, float64(count), l...)
+		}
+	}
+
+	return nil
+}
+
+// vlanCounts counts the MAC table entries per routing instance and VLAN
+func vlanCounts(x *macTableResult) map[string]map[string]int {
+	counts := make(map[string]map[string]int)
+	for _, v := range x.Database.VLANs {
+		ri := strings.TrimSpace(v.RoutingInstance)
+		if counts[ri] == nil {
+			counts[ri] = make(map[string]int)
+		}
+
+		for _, e := range v.Entries {
+			counts[ri][strings.TrimSpace(e.VLANName)]++
+		}
+	}
+
+	return counts
+}
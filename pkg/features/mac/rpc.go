@@ -20,3 +20,19 @@ type macTableEntry struct {
 	DynamicCount int64 `xml:"mac-table-dynamic-count"`
 	FloodCount   int64 `xml:"mac-table-flood-count"`
 }
+
+type macTableResult struct {
+	Database struct {
+		VLANs []macEntryVLAN `xml:"l2ng-l2ald-mac-entry-vlan"`
+	} `xml:"l2ng-l2ald-rtb-macdb"`
+}
+
+type macEntryVLAN struct {
+	RoutingInstance string     `xml:"l2ng-l2-mac-routing-instance"`
+	Entries         []macEntry `xml:"l2ng-mac-entry"`
+}
+
+type macEntry struct {
+	VLANName string `xml:"l2ng-l2-mac-vlan-name"`
+	Address  string `xml:"l2ng-l2-mac-address"`
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+
+package mac
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMACTableVLANCounts(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<l2ng-l2ald-rtb-macdb>
+    <l2ng-l2ald-mac-entry-vlan junos:style="brief-rtb">
+        <mac-count-global>3</mac-count-global>
+        <learnt-mac-count>3</learnt-mac-count>
+        <l2ng-l2-mac-routing-instance>default-switch</l2ng-l2-mac-routing-instance>
+        <l2ng-l2-vlan-id>100</l2ng-l2-vlan-id>
+        <l2ng-mac-entry>
+            <l2ng-l2-mac-vlan-name>v100</l2ng-l2-mac-vlan-name>
+            <l2ng-l2-mac-address>00:11:22:33:44:55</l2ng-l2-mac-address>
+            <l2ng-l2-mac-flags>D</l2ng-l2-mac-flags>
+            <l2ng-l2-mac-logical-interface>ge-0/0/1.0</l2ng-l2-mac-logical-interface>
+        </l2ng-mac-entry>
+        <l2ng-mac-entry>
+            <l2ng-l2-mac-vlan-name>v100</l2ng-l2-mac-vlan-name>
+            <l2ng-l2-mac-address>00:11:22:33:44:66</l2ng-l2-mac-address>
+            <l2ng-l2-mac-flags>D</l2ng-l2-mac-flags>
+            <l2ng-l2-mac-logical-interface>ge-0/0/2.0</l2ng-l2-mac-logical-interface>
+        </l2ng-mac-entry>
+        <l2ng-mac-entry>
+            <l2ng-l2-mac-vlan-name>v200</l2ng-l2-mac-vlan-name>
+            <l2ng-l2-mac-address>00:11:22:33:44:77</l2ng-l2-mac-address>
+            <l2ng-l2-mac-flags>D</l2ng-l2-mac-flags>
+            <l2ng-l2-mac-logical-interface>ae0.0</l2ng-l2-mac-logical-interface>
+        </l2ng-mac-entry>
+    </l2ng-l2ald-mac-entry-vlan>
+</l2ng-l2ald-rtb-macdb>
+</rpc-reply>`
+
+	var x macTableResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	counts := vlanCounts(&x)
+	assert.Equal(t, map[string]map[string]int{
+		"default-switch": {"v100": 2, "v200": 1},
+	}, counts)
+}
@@ -3,16 +3,23 @@
 package mplslsp
 
 import (
+	"strings"
+
 	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/parse"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 const prefix = "junos_mpls_lsp_"
 
 var (
-	lspState         *prometheus.Desc
-	lspPathState     *prometheus.Desc
-	lspPathFlapCount *prometheus.Desc
+	lspState               *prometheus.Desc
+	lspPackets             *prometheus.Desc
+	lspBytes               *prometheus.Desc
+	lspConfiguredBandwidth *prometheus.Desc
+	lspSignalledBandwidth  *prometheus.Desc
+	lspPathState           *prometheus.Desc
+	lspPathFlapCount       *prometheus.Desc
 
 	lspStateMap = map[string]int{
 		"Dn": 0,
@@ -21,8 +28,12 @@ var (
 )
 
 func init() {
-	ls := []string{"target", "lspname", "lspsrc", "lspdst"}
+	ls := []string{"target", "lspname", "lspsrc", "lspdst", "type"}
 	lspState = prometheus.NewDesc(prefix+"state", "mpls_lsp state (0: down, 1:up)", ls, nil)
+	lspPackets = prometheus.NewDesc(prefix+"packets", "Number of packets forwarded over the LSP", ls, nil)
+	lspBytes = prometheus.NewDesc(prefix+"bytes", "Number of bytes forwarded over the LSP", ls, nil)
+	lspConfiguredBandwidth = prometheus.NewDesc(prefix+"configured_bandwidth_bps", "Bandwidth configured for the active path of the LSP in bits per second", ls, nil)
+	lspSignalledBandwidth = prometheus.NewDesc(prefix+"signalled_bandwidth_bps", "Bandwidth signalled for the LSP in bits per second", ls, nil)
 
 	lps := []string{"target", "lspname", "lspsrc", "lspdst", "title", "name"}
 	lspPathState = prometheus.NewDesc(prefix+"path_state", "mpls_lsp pathstate (0: down, 1:up)", lps, nil)
@@ -45,26 +56,67 @@ func NewCollector() collector.RPCCollector {
 // Describe describes the metrics
 func (*mplsLSPCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- lspState
+	ch <- lspPackets
+	ch <- lspBytes
+	ch <- lspConfiguredBandwidth
+	ch <- lspSignalledBandwidth
+	ch <- lspPathState
+	ch <- lspPathFlapCount
 }
 
 // Collect collects metrics from JunOS
 func (c *mplsLSPCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	var x = result{}
-	err := client.RunCommandAndParse("show mpls lsp ingress extensive", &x) //ingress:Display LSPs originating at this router
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show mpls lsp extensive statistics", client), &x)
 	if err != nil {
 		return err
 	}
 
-	for _, lsp := range x.Information.Sessions {
-		l := append(labelValues, lsp.Name, lsp.SrcIP, lsp.DstIP)
-		ch <- prometheus.MustNewConstMetric(lspState, prometheus.GaugeValue, float64(lspStateMap[lsp.LSPState]), l...)
+	for _, data := range x.Information.SessionData {
+		collector.ReportRecords(client, len(data.Sessions))
+		sessionType := strings.ToLower(data.SessionType)
+
+		for _, s := range data.Sessions {
+			if sessionType == "ingress" {
+				c.collectForIngressLSP(s.LSP, ch, labelValues)
+				continue
+			}
 
-		for _, path := range lsp.Path {
-			l := append(labelValues, lsp.Name, lsp.SrcIP, lsp.DstIP, path.Title, path.Name)
-			ch <- prometheus.MustNewConstMetric(lspPathState, prometheus.GaugeValue, float64(lspStateMap[path.State]), l...)
-			ch <- prometheus.MustNewConstMetric(lspPathFlapCount, prometheus.GaugeValue, float64(path.FlapCount), l...)
+			c.collectForSession(s, sessionType, ch, labelValues)
 		}
 	}
 
 	return nil
 }
+
+func (c *mplsLSPCollector) collectForIngressLSP(lsp lsp, ch chan<- prometheus.Metric, labelValues []string) {
+	l := append(labelValues, lsp.Name, lsp.SrcIP, lsp.DstIP, "ingress")
+	ch <- prometheus.MustNewConstMetric(lspState, prometheus.GaugeValue, float64(lspStateMap[lsp.LSPState]), l...)
+	ch <- prometheus.MustNewConstMetric(lspPackets, prometheus.CounterValue, float64(lsp.Packets), l...)
+	ch <- prometheus.MustNewConstMetric(lspBytes, prometheus.CounterValue, float64(lsp.Bytes), l...)
+
+	if bw, err := parse.Bandwidth(configuredBandwidth(lsp)); err == nil {
+		ch <- prometheus.MustNewConstMetric(lspConfiguredBandwidth, prometheus.GaugeValue, bw, l...)
+	}
+
+	if bw, err := parse.Bandwidth(lsp.SignalledBandwidth); err == nil {
+		ch <- prometheus.MustNewConstMetric(lspSignalledBandwidth, prometheus.GaugeValue, bw, l...)
+	}
+
+	for _, path := range lsp.Path {
+		l := append(labelValues, lsp.Name, lsp.SrcIP, lsp.DstIP, path.Title, path.Name)
+		ch <- prometheus.MustNewConstMetric(lspPathState, prometheus.GaugeValue, float64(lspStateMap[path.State]), l...)
+		ch <- prometheus.MustNewConstMetric(lspPathFlapCount, prometheus.GaugeValue, float64(path.FlapCount), l...)
+	}
+}
+
+func (c *mplsLSPCollector) collectForSession(s lspSession, sessionType string, ch chan<- prometheus.Metric, labelValues []string) {
+	l := append(labelValues, s.Name, s.SrcIP, s.DstIP, sessionType)
+	ch <- prometheus.MustNewConstMetric(lspState, prometheus.GaugeValue, float64(lspStateMap[s.LSPState]), l...)
+	ch <- prometheus.MustNewConstMetric(lspPackets, prometheus.CounterValue, float64(s.Packets), l...)
+	ch <- prometheus.MustNewConstMetric(lspBytes, prometheus.CounterValue, float64(s.Bytes), l...)
+
+	if bw, err := parse.Bandwidth(s.Bandwidth); err == nil {
+		ch <- prometheus.MustNewConstMetric(lspSignalledBandwidth, prometheus.GaugeValue, bw, l...)
+	}
+}
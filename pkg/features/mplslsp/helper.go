@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: MIT
+
+package mplslsp
+
+// configuredBandwidth returns the bandwidth configured for the active path of the LSP (or the first path if none is active)
+func configuredBandwidth(l lsp) string {
+	for _, p := range l.Path {
+		if p.Active != nil {
+			return p.Bandwidth
+		}
+	}
+
+	if len(l.Path) > 0 {
+		return l.Path[0].Bandwidth
+	}
+
+	return ""
+}
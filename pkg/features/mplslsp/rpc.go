@@ -4,22 +4,46 @@ package mplslsp
 
 type result struct {
 	Information struct {
-		Sessions []lspSession `xml:"rsvp-session"`
-	} `xml:"mpls-lsp-information>rsvp-session-data"`
+		SessionData []sessionData `xml:"rsvp-session-data"`
+	} `xml:"mpls-lsp-information"`
+}
+
+type sessionData struct {
+	SessionType string       `xml:"session-type"`
+	Sessions    []lspSession `xml:"rsvp-session"`
 }
 
 type lspSession struct {
-	DstIP    string `xml:"mpls-lsp>destination-address"`
-	SrcIP    string `xml:"mpls-lsp>source-address"`
-	LSPState string `xml:"mpls-lsp>lsp-state"`
-	Name     string `xml:"mpls-lsp>name"`
+	// ingress sessions carry the LSP details inside a mpls-lsp element
+	LSP lsp `xml:"mpls-lsp"`
 
-	Path []lspPath `xml:"mpls-lsp>mpls-lsp-path"`
+	// transit and egress sessions carry the details directly in the session
+	DstIP     string `xml:"destination-address"`
+	SrcIP     string `xml:"source-address"`
+	LSPState  string `xml:"lsp-state"`
+	Name      string `xml:"name"`
+	Bandwidth string `xml:"bandwidth"`
+	Packets   int64  `xml:"lsp-packets"`
+	Bytes     int64  `xml:"lsp-bytes"`
+}
+
+type lsp struct {
+	DstIP              string `xml:"destination-address"`
+	SrcIP              string `xml:"source-address"`
+	LSPState           string `xml:"lsp-state"`
+	Name               string `xml:"name"`
+	SignalledBandwidth string `xml:"signalled-bandwidth"`
+	Packets            int64  `xml:"lsp-packets"`
+	Bytes              int64  `xml:"lsp-bytes"`
+
+	Path []lspPath `xml:"mpls-lsp-path"`
 }
 
 type lspPath struct {
-	Title     string `xml:"title"`
-	Name      string `xml:"name"`
-	State     string `xml:"path-state"`
-	FlapCount int64  `xml:"path-flap-count"`
+	Title     string    `xml:"title"`
+	Name      string    `xml:"name"`
+	State     string    `xml:"path-state"`
+	Active    *struct{} `xml:"path-active"`
+	Bandwidth string    `xml:"bandwidth"`
+	FlapCount int64     `xml:"path-flap-count"`
 }
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+
+package mplslsp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIngressAndTransitSessions(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+		<mpls-lsp-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-routing">
+			<rsvp-session-data>
+				<session-type>Ingress</session-type>
+				<count>1</count>
+				<rsvp-session>
+					<mpls-lsp>
+						<destination-address>192.0.2.2</destination-address>
+						<source-address>192.0.2.1</source-address>
+						<lsp-state>Up</lsp-state>
+						<name>to-r2</name>
+						<signalled-bandwidth>100Mbps</signalled-bandwidth>
+						<lsp-packets>1000</lsp-packets>
+						<lsp-bytes>64000</lsp-bytes>
+						<mpls-lsp-path>
+							<title>Primary</title>
+							<name>via-r3</name>
+							<path-state>Dn</path-state>
+							<bandwidth>50Mbps</bandwidth>
+							<path-flap-count>3</path-flap-count>
+						</mpls-lsp-path>
+						<mpls-lsp-path>
+							<title>Secondary</title>
+							<name>via-r4</name>
+							<path-active/>
+							<path-state>Up</path-state>
+							<bandwidth>100Mbps</bandwidth>
+							<path-flap-count>1</path-flap-count>
+						</mpls-lsp-path>
+					</mpls-lsp>
+				</rsvp-session>
+			</rsvp-session-data>
+			<rsvp-session-data>
+				<session-type>Transit</session-type>
+				<count>1</count>
+				<rsvp-session>
+					<destination-address>192.0.2.5</destination-address>
+					<source-address>192.0.2.4</source-address>
+					<lsp-state>Up</lsp-state>
+					<name>r4-to-r5</name>
+					<bandwidth>1.5Gbps</bandwidth>
+					<lsp-packets>20</lsp-packets>
+					<lsp-bytes>3000</lsp-bytes>
+				</rsvp-session>
+			</rsvp-session-data>
+		</mpls-lsp-information>
+	</rpc-reply>`
+
+	rpc := result{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(rpc.Information.SessionData), "session data")
+
+	ingress := rpc.Information.SessionData[0]
+	assert.Equal(t, "Ingress", ingress.SessionType, "session-type")
+
+	l := ingress.Sessions[0].LSP
+	assert.Equal(t, "to-r2", l.Name, "name")
+	assert.Equal(t, "192.0.2.1", l.SrcIP, "source-address")
+	assert.Equal(t, "192.0.2.2", l.DstIP, "destination-address")
+	assert.Equal(t, int64(1000), l.Packets, "lsp-packets")
+	assert.Equal(t, int64(64000), l.Bytes, "lsp-bytes")
+	assert.Equal(t, 2, len(l.Path), "paths")
+	assert.Equal(t, "100Mbps", configuredBandwidth(l), "configured bandwidth")
+
+	transit := rpc.Information.SessionData[1]
+	assert.Equal(t, "Transit", transit.SessionType, "session-type")
+
+	s := transit.Sessions[0]
+	assert.Equal(t, "r4-to-r5", s.Name, "name")
+	assert.Equal(t, "192.0.2.4", s.SrcIP, "source-address")
+	assert.Equal(t, "Up", s.LSPState, "lsp-state")
+	assert.Equal(t, int64(3000), s.Bytes, "lsp-bytes")
+	assert.Equal(t, "1.5Gbps", s.Bandwidth, "bandwidth")
+}
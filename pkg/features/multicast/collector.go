@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+
+package multicast
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_multicast_"
+
+var (
+	pimNeighborUpDesc     *prometheus.Desc
+	pimNeighborUptimeDesc *prometheus.Desc
+	pimNeighborCountDesc  *prometheus.Desc
+	routesActiveDesc      *prometheus.Desc
+	routesDesc            *prometheus.Desc
+	routeActiveDesc       *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface", "neighbor", "version"}
+	pimNeighborUpDesc = prometheus.NewDesc("junos_pim_neighbor_up", "PIM neighbor is established (1 = up)", l, nil)
+	pimNeighborUptimeDesc = prometheus.NewDesc("junos_pim_neighbor_uptime_seconds", "Seconds since the PIM neighbor was established", l, nil)
+
+	l = []string{"target", "interface"}
+	pimNeighborCountDesc = prometheus.NewDesc("junos_pim_neighbor_count", "Number of PIM neighbors per interface", l, nil)
+
+	l = []string{"target", "instance", "family"}
+	routesActiveDesc = prometheus.NewDesc(prefix+"routes_active_count", "Number of active multicast routes", l, nil)
+	routesDesc = prometheus.NewDesc(prefix+"routes_count", "Number of multicast routes", l, nil)
+
+	l = []string{"target", "instance", "family", "group", "source", "upstream_interface"}
+	routeActiveDesc = prometheus.NewDesc(prefix+"route_active", "Multicast route is active (1 = active), limited to the configured number of routes per instance", l, nil)
+}
+
+type multicastCollector struct {
+	routeLimit int
+}
+
+// NewCollector creates a new collector. Metrics per multicast route are exported for at most routeLimit routes per instance (0 = disabled).
+func NewCollector(routeLimit int) collector.RPCCollector {
+	return &multicastCollector{routeLimit: routeLimit}
+}
+
+// Name returns the name of the collector
+func (*multicastCollector) Name() string {
+	return "Multicast"
+}
+
+// Describe describes the metrics
+func (*multicastCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pimNeighborUpDesc
+	ch <- pimNeighborUptimeDesc
+	ch <- pimNeighborCountDesc
+	ch <- routesActiveDesc
+	ch <- routesDesc
+	ch <- routeActiveDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *multicastCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var n = pimNeighborResult{}
+	err := client.RunCommandAndParse("show pim neighbors", &n)
+	if err != nil {
+		return err
+	}
+
+	c.collectPIMNeighbors(&n, ch, labelValues)
+
+	var r = multicastRouteResult{}
+	err = client.RunCommandAndParse("show multicast route", &r)
+	if err != nil {
+		return err
+	}
+
+	c.collectRoutes(&r, ch, labelValues)
+
+	return nil
+}
+
+func (c *multicastCollector) collectPIMNeighbors(x *pimNeighborResult, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, iface := range x.Information.Interfaces {
+		l := append(labelValues[:len(labelValues):len(labelValues)], iface.Name)
+		ch <- prometheus.MustNewConstMetric(pimNeighborCountDesc, prometheus.GaugeValue, float64(len(iface.Neighbors)), l...)
+
+		for _, n := range iface.Neighbors {
+			nl := append(l[:len(l):len(l)], n.Address, n.ProtocolVersion)
+			ch <- prometheus.MustNewConstMetric(pimNeighborUpDesc, prometheus.GaugeValue, 1, nl...)
+			ch <- prometheus.MustNewConstMetric(pimNeighborUptimeDesc, prometheus.GaugeValue, float64(n.Uptime.Seconds), nl...)
+		}
+	}
+}
+
+func (c *multicastCollector) collectRoutes(x *multicastRouteResult, ch chan<- prometheus.Metric, labelValues []string) {
+	for _, info := range x.Information {
+		instance := info.Instance
+		if instance == "" {
+			instance = "master"
+		}
+
+		l := append(labelValues[:len(labelValues):len(labelValues)], instance, strings.ToLower(info.Family))
+
+		active := 0
+		for i, r := range info.Routes {
+			isActive := isActiveRoute(r)
+			if isActive {
+				active++
+			}
+
+			if i >= c.routeLimit {
+				continue
+			}
+
+			v := 0
+			if isActive {
+				v = 1
+			}
+			rl := append(l[:len(l):len(l)], r.Group, r.Source, r.Upstream)
+			ch <- prometheus.MustNewConstMetric(routeActiveDesc, prometheus.GaugeValue, float64(v), rl...)
+		}
+
+		ch <- prometheus.MustNewConstMetric(routesActiveDesc, prometheus.GaugeValue, float64(active), l...)
+		ch <- prometheus.MustNewConstMetric(routesDesc, prometheus.GaugeValue, float64(len(info.Routes)), l...)
+	}
+}
+
+func isActiveRoute(r multicastRoute) bool {
+	return strings.EqualFold(strings.TrimSpace(r.State), "active")
+}
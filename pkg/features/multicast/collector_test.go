@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package multicast
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectRoutesLimit(t *testing.T) {
+	x := &multicastRouteResult{
+		Information: []multicastRouteInformation{
+			{
+				Family: "INET",
+				Routes: []multicastRoute{
+					{Group: "232.1.1.1", Source: "198.51.100.1/32", State: "Active"},
+					{Group: "232.1.1.2", Source: "198.51.100.2/32", State: "Active"},
+					{Group: "232.1.1.3", Source: "198.51.100.3/32", State: "Inactive"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		limit  int
+		routes int
+	}{
+		{name: "disabled", limit: 0, routes: 0},
+		{name: "limited", limit: 2, routes: 2},
+		{name: "above route count", limit: 10, routes: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &multicastCollector{routeLimit: test.limit}
+			ch := make(chan prometheus.Metric, 10)
+			c.collectRoutes(x, ch, []string{"router1"})
+			close(ch)
+
+			routes := 0
+			for m := range ch {
+				if m.Desc() == routeActiveDesc {
+					routes++
+				}
+			}
+
+			assert.Equal(t, test.routes, routes, "route metrics")
+		})
+	}
+}
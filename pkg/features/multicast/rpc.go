@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+
+package multicast
+
+type pimNeighborResult struct {
+	Information struct {
+		Interfaces []pimInterface `xml:"pim-interface"`
+	} `xml:"pim-neighbors-information"`
+}
+
+type pimInterface struct {
+	Name      string        `xml:"pim-interface-name"`
+	Neighbors []pimNeighbor `xml:"pim-neighbor"`
+}
+
+type pimNeighbor struct {
+	Address         string `xml:"pim-neighbor-address"`
+	ProtocolVersion string `xml:"protocol-version"`
+	Uptime          struct {
+		Seconds uint64 `xml:"seconds,attr"`
+		Value   string `xml:",chardata"`
+	} `xml:"neighbor-uptime"`
+}
+
+type multicastRouteResult struct {
+	Information []multicastRouteInformation `xml:"multicast-route-information"`
+}
+
+type multicastRouteInformation struct {
+	Instance string           `xml:"instance-name"`
+	Family   string           `xml:"route-family"`
+	Routes   []multicastRoute `xml:"multicast-route"`
+}
+
+type multicastRoute struct {
+	Group    string `xml:"multicast-group-address"`
+	Source   string `xml:"multicast-source-address"`
+	Upstream string `xml:"upstream-interface-name"`
+	State    string `xml:"route-state"`
+}
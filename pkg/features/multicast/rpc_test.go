@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+
+package multicast
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePIMNeighbors(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<pim-neighbors-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-routing">
+    <pim-interface>
+        <pim-interface-name>ae0.0</pim-interface-name>
+        <pim-neighbor>
+            <pim-neighbor-address>192.0.2.1</pim-neighbor-address>
+            <protocol-version>2</protocol-version>
+            <pim-neighbor-flags>HPLGT</pim-neighbor-flags>
+            <neighbor-uptime junos:seconds="93784">1d 02:03:04</neighbor-uptime>
+        </pim-neighbor>
+        <pim-neighbor>
+            <pim-neighbor-address>192.0.2.2</pim-neighbor-address>
+            <protocol-version>2</protocol-version>
+            <neighbor-uptime junos:seconds="60">00:01:00</neighbor-uptime>
+        </pim-neighbor>
+    </pim-interface>
+    <pim-interface>
+        <pim-interface-name>xe-0/0/1.0</pim-interface-name>
+    </pim-interface>
+</pim-neighbors-information>
+</rpc-reply>`
+
+	var x pimNeighborResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Interfaces), "interface count")
+
+	iface := x.Information.Interfaces[0]
+	assert.Equal(t, "ae0.0", iface.Name)
+	assert.Equal(t, 2, len(iface.Neighbors), "neighbor count")
+	assert.Equal(t, "192.0.2.1", iface.Neighbors[0].Address)
+	assert.Equal(t, "2", iface.Neighbors[0].ProtocolVersion)
+	assert.Equal(t, uint64(93784), iface.Neighbors[0].Uptime.Seconds)
+	assert.Equal(t, uint64(60), iface.Neighbors[1].Uptime.Seconds)
+
+	assert.Equal(t, 0, len(x.Information.Interfaces[1].Neighbors), "no neighbors")
+}
+
+func TestParseMulticastRoutes(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<multicast-route-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-routing">
+    <route-family>INET</route-family>
+    <multicast-route>
+        <multicast-group-address>232.1.1.1</multicast-group-address>
+        <multicast-source-address>198.51.100.1/32</multicast-source-address>
+        <upstream-interface-name>ae0.0</upstream-interface-name>
+        <route-state>Active</route-state>
+    </multicast-route>
+    <multicast-route>
+        <multicast-group-address>232.1.1.2</multicast-group-address>
+        <multicast-source-address>198.51.100.2/32</multicast-source-address>
+        <upstream-interface-name>ae0.0</upstream-interface-name>
+        <route-state>Inactive</route-state>
+    </multicast-route>
+</multicast-route-information>
+</rpc-reply>`
+
+	var x multicastRouteResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information), "family count")
+
+	info := x.Information[0]
+	assert.Equal(t, "INET", info.Family)
+	assert.Equal(t, 2, len(info.Routes), "route count")
+	assert.Equal(t, "232.1.1.1", info.Routes[0].Group)
+	assert.Equal(t, "198.51.100.1/32", info.Routes[0].Source)
+	assert.Equal(t, "ae0.0", info.Routes[0].Upstream)
+	assert.True(t, isActiveRoute(info.Routes[0]), "active")
+	assert.False(t, isActiveRoute(info.Routes[1]), "inactive")
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+
+package ntp
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_ntp_"
+
+var (
+	syncedDesc        *prometheus.Desc
+	offsetDesc        *prometheus.Desc
+	jitterDesc        *prometheus.Desc
+	stratumDesc       *prometheus.Desc
+	systemSyncedDesc  *prometheus.Desc
+	systemOffsetDesc  *prometheus.Desc
+	systemStratumDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "server"}
+	syncedDesc = prometheus.NewDesc(prefix+"synced", "Clock is synchronized to the server (1 = selected as system peer)", l, nil)
+	offsetDesc = prometheus.NewDesc(prefix+"offset_seconds", "Offset of the clock to the server", l, nil)
+	jitterDesc = prometheus.NewDesc(prefix+"jitter_seconds", "Jitter of the offset to the server", l, nil)
+	stratumDesc = prometheus.NewDesc(prefix+"stratum", "Stratum of the server", l, nil)
+
+	l = []string{"target"}
+	systemSyncedDesc = prometheus.NewDesc(prefix+"system_synced", "Clock of the device is synchronized by NTP (1 = synced)", l, nil)
+	systemOffsetDesc = prometheus.NewDesc(prefix+"system_offset_seconds", "Offset of the clock of the device to its system peer", l, nil)
+	systemStratumDesc = prometheus.NewDesc(prefix+"system_stratum", "Stratum of the device", l, nil)
+}
+
+type ntpCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &ntpCollector{}
+}
+
+// Name returns the name of the collector
+func (*ntpCollector) Name() string {
+	return "NTP"
+}
+
+// Describe describes the metrics
+func (*ntpCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- syncedDesc
+	ch <- offsetDesc
+	ch <- jitterDesc
+	ch <- stratumDesc
+	ch <- systemSyncedDesc
+	ch <- systemOffsetDesc
+	ch <- systemStratumDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *ntpCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var a = outputResult{}
+	err := client.RunCommandAndParse("show ntp associations", &a)
+	if err != nil {
+		return err
+	}
+
+	for _, x := range parseAssociations(a.Output) {
+		l := append(labelValues[:len(labelValues):len(labelValues)], x.Server)
+		ch <- prometheus.MustNewConstMetric(syncedDesc, prometheus.GaugeValue, boolToFloat(x.Synced), l...)
+		ch <- prometheus.MustNewConstMetric(offsetDesc, prometheus.GaugeValue, x.Offset, l...)
+		ch <- prometheus.MustNewConstMetric(jitterDesc, prometheus.GaugeValue, x.Jitter, l...)
+		ch <- prometheus.MustNewConstMetric(stratumDesc, prometheus.GaugeValue, x.Stratum, l...)
+	}
+
+	var s = outputResult{}
+	err = client.RunCommandAndParse("show ntp status", &s)
+	if err != nil {
+		return err
+	}
+
+	st := parseStatus(s.Output)
+	if !st.Found {
+		return nil
+	}
+
+	ch <- prometheus.MustNewConstMetric(systemSyncedDesc, prometheus.GaugeValue, boolToFloat(st.Synced), labelValues...)
+	ch <- prometheus.MustNewConstMetric(systemOffsetDesc, prometheus.GaugeValue, st.Offset, labelValues...)
+	ch <- prometheus.MustNewConstMetric(systemStratumDesc, prometheus.GaugeValue, st.Stratum, labelValues...)
+
+	return nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
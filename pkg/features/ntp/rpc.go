@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+
+package ntp
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+)
+
+// outputResult is the reply of commands Junos only returns as plain text
+type outputResult struct {
+	Output string `xml:"output"`
+}
+
+type association struct {
+	Server  string
+	Synced  bool
+	Stratum float64
+	Offset  float64
+	Jitter  float64
+}
+
+type status struct {
+	Synced  bool
+	Stratum float64
+	Offset  float64
+	Found   bool
+}
+
+// parseAssociations parses the peer table of show ntp associations, offset and jitter are converted from milliseconds to seconds
+func parseAssociations(output string) []*association {
+	associations := make([]*association, 0)
+
+	s := bufio.NewScanner(strings.NewReader(output))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 10 || fields[0] == "remote" {
+			continue
+		}
+
+		server := fields[0]
+		tally := ""
+		if strings.ContainsAny(server[:1], "*#o+-x.") {
+			tally = server[:1]
+			server = server[1:]
+		}
+
+		stratum, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			continue
+		}
+
+		offset, _ := strconv.ParseFloat(fields[8], 64)
+		jitter, _ := strconv.ParseFloat(fields[9], 64)
+
+		associations = append(associations, &association{
+			Server:  server,
+			Synced:  tally == "*" || tally == "o",
+			Stratum: stratum,
+			Offset:  offset / 1000,
+			Jitter:  jitter / 1000,
+		})
+	}
+
+	return associations
+}
+
+// parseStatus parses the system variables of show ntp status, the offset is converted from milliseconds to seconds
+func parseStatus(output string) *status {
+	st := &status{}
+
+	for _, v := range strings.FieldsFunc(output, func(r rune) bool { return r == ',' || r == '\n' }) {
+		v = strings.TrimSpace(v)
+		if v == "sync_ntp" {
+			st.Synced = true
+			continue
+		}
+
+		key, value, found := strings.Cut(v, "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "stratum":
+			st.Stratum, _ = strconv.ParseFloat(value, 64)
+			st.Found = true
+		case "offset":
+			offset, _ := strconv.ParseFloat(value, 64)
+			st.Offset = offset / 1000
+		}
+	}
+
+	return st
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+
+package ntp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAssociations(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<output>
+     remote           refid      st t when poll reach   delay   offset  jitter
+==============================================================================
+*192.0.2.1       .GPS.            1 u  112 1024  377    0.412   -0.215   0.038
++192.0.2.2       192.0.2.1        2 u  870 1024  377    1.025    1.730   0.211
+ ntp.example.com .INIT.          16 -    - 1024    0    0.000    0.000   0.000
+</output>
+</rpc-reply>`
+
+	var x outputResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	a := parseAssociations(x.Output)
+	assert.Equal(t, 3, len(a), "association count")
+
+	assert.Equal(t, "192.0.2.1", a[0].Server, "remote")
+	assert.True(t, a[0].Synced, "system peer")
+	assert.Equal(t, float64(1), a[0].Stratum, "st")
+	assert.InDelta(t, -0.000215, a[0].Offset, 1e-9, "offset")
+	assert.InDelta(t, 0.000038, a[0].Jitter, 1e-9, "jitter")
+
+	assert.Equal(t, "192.0.2.2", a[1].Server, "remote")
+	assert.False(t, a[1].Synced, "candidate")
+	assert.InDelta(t, 0.00173, a[1].Offset, 1e-9, "offset")
+
+	assert.Equal(t, "ntp.example.com", a[2].Server, "remote")
+	assert.False(t, a[2].Synced, "unreachable")
+	assert.Equal(t, float64(16), a[2].Stratum, "st")
+}
+
+func TestParseStatus(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<output>
+status=0644 leap_none, sync_ntp, 4 events, event_peer/strat_chg,
+version="ntpd 4.2.0-a Thu Mar  2 07:12:35  2023 (1)",
+processor="amd64", system="JUNOS21.4R3-S3.4", leap=00, stratum=2,
+precision=-23, rootdelay=0.412, rootdispersion=16.226, peer=60580,
+refid=192.0.2.1, reftime=e8a1c604.7b2d5a1c  Tue, Aug 29 2023 10:12:20.481,
+poll=10, clock=e8a1c7f1.1c89f3a2  Tue, Aug 29 2023 10:20:33.111, state=4,
+offset=-0.215, frequency=-12.518, jitter=0.038, noise=0.021,
+stability=0.003, tai=0
+</output>
+</rpc-reply>`
+
+	var x outputResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	s := parseStatus(x.Output)
+	assert.True(t, s.Found, "stratum found")
+	assert.True(t, s.Synced, "sync_ntp")
+	assert.Equal(t, float64(2), s.Stratum, "stratum")
+	assert.InDelta(t, -0.000215, s.Offset, 1e-9, "offset")
+
+	s = parseStatus("status=c011 leap_alarm, sync_unspec, 1 event, event_restart,\nleap=11, stratum=16, offset=0.000")
+	assert.False(t, s.Synced, "sync_unspec")
+	assert.Equal(t, float64(16), s.Stratum, "stratum")
+}
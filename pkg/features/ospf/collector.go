@@ -3,40 +3,32 @@
 package ospf
 
 import (
-	"strings"
-
 	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
-	ospfUpDesc         *prometheus.Desc
-	ospf3UpDesc        *prometheus.Desc
-	ospfNeighborsDesc  *prometheus.Desc
-	ospf3NeighborsDesc *prometheus.Desc
+	ospfUpDesc        *prometheus.Desc
+	ospfNeighborsDesc *prometheus.Desc
 )
 
 func init() {
 	ospfPrefix := "junos_ospf_"
-	ospf3Prefix := "junos_ospf3_"
 
 	l := []string{"target"}
 	ospfUpDesc = prometheus.NewDesc(ospfPrefix+"up", "OSPF is up and running (1 = up)", l, nil)
-	ospf3UpDesc = prometheus.NewDesc(ospf3Prefix+"up", "OSPFv3 is up and running (1 = up)", l, nil)
 
 	l = append(l, "area")
 	ospfNeighborsDesc = prometheus.NewDesc(ospfPrefix+"neighbors_count", "Number of neighbors", l, nil)
-	ospf3NeighborsDesc = prometheus.NewDesc(ospf3Prefix+"neighbors_count", "Number of neighbors", l, nil)
 }
 
-// Collector collects OSPFv3 metrics
+// Collector collects OSPFv2 metrics
 type ospfCollector struct {
-	LogicalSystem string
 }
 
 // NewCollector creates a new collector
-func NewCollector(logicalSystem string) collector.RPCCollector {
-	return &ospfCollector{LogicalSystem: logicalSystem}
+func NewCollector() collector.RPCCollector {
+	return &ospfCollector{}
 }
 
 // Name returns the name of the collector
@@ -47,30 +39,17 @@ func (*ospfCollector) Name() string {
 // Describe describes the metrics
 func (*ospfCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- ospfUpDesc
-	ch <- ospf3UpDesc
 	ch <- ospfNeighborsDesc
-	ch <- ospf3NeighborsDesc
 }
 
 // Collect collects metrics from JunOS
 func (c *ospfCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
-	err := c.collectOSPFMetrics(client, ch, labelValues)
-	if err != nil {
-		return err
-	}
-
-	return c.collectOSPFv3Metrics(client, ch, labelValues)
+	return c.collectOSPFMetrics(client, ch, labelValues)
 }
 
 func (c *ospfCollector) collectOSPFMetrics(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
-	var x = v2Result{}
-	var cmd strings.Builder
-	cmd.WriteString("show ospf overview")
-	if c.LogicalSystem != "" {
-		cmd.WriteString(" logical-system " + c.LogicalSystem)
-	}
-
-	err := client.RunCommandAndParse(cmd.String(), &x)
+	var x = result{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show ospf overview", client), client), &x)
 	if err != nil {
 		return err
 	}
@@ -84,6 +63,7 @@ func (c *ospfCollector) collectOSPFMetrics(client collector.Client, ch chan<- pr
 
 	ch <- prometheus.MustNewConstMetric(ospfUpDesc, prometheus.GaugeValue, float64(up), labelValues...)
 
+	collector.ReportRecords(client, len(areas))
 	for _, a := range areas {
 		l := append(labelValues, a.Name)
 		ch <- prometheus.MustNewConstMetric(ospfNeighborsDesc, prometheus.GaugeValue, float64(a.Neighbors.NeighborsUp), l...)
@@ -91,33 +71,3 @@ func (c *ospfCollector) collectOSPFMetrics(client collector.Client, ch chan<- pr
 
 	return nil
 }
-
-func (c *ospfCollector) collectOSPFv3Metrics(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
-	var x = v3Result{}
-	var cmd strings.Builder
-	cmd.WriteString("show ospf3 overview")
-	if c.LogicalSystem != "" {
-		cmd.WriteString(" logical-system " + c.LogicalSystem)
-	}
-
-	err := client.RunCommandAndParse(cmd.String(), &x)
-	if err != nil {
-		return err
-	}
-
-	areas := x.Information.Overview.Areas
-
-	up := 0
-	if len(areas) > 0 {
-		up = 1
-	}
-
-	ch <- prometheus.MustNewConstMetric(ospf3UpDesc, prometheus.GaugeValue, float64(up), labelValues...)
-
-	for _, a := range areas {
-		l := append(labelValues, a.Name)
-		ch <- prometheus.MustNewConstMetric(ospf3NeighborsDesc, prometheus.GaugeValue, float64(a.Neighbors.NeighborsUp), l...)
-	}
-
-	return nil
-}
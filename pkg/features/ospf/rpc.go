@@ -2,7 +2,7 @@
 
 package ospf
 
-type v2Result struct {
+type result struct {
 	Information struct {
 		Overview struct {
 			Areas []area `xml:"ospf-area-overview"`
@@ -10,14 +10,6 @@ type v2Result struct {
 	} `xml:"ospf-overview-information"`
 }
 
-type v3Result struct {
-	Information struct {
-		Overview struct {
-			Areas []area `xml:"ospf-area-overview"`
-		} `xml:"ospf-overview"`
-	} `xml:"ospf3-overview-information"`
-}
-
 type area struct {
 	Name      string `xml:"ospf-area"`
 	Neighbors struct {
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+
+package ospf3
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix = "junos_ospf3_"
+
+var (
+	upDesc                     *prometheus.Desc
+	neighborsDesc              *prometheus.Desc
+	neighborStateDesc          *prometheus.Desc
+	interfaceStateDesc         *prometheus.Desc
+	interfaceNeighborCountDesc *prometheus.Desc
+
+	neighborStates = map[string]int{
+		"Down":     0,
+		"Attempt":  1,
+		"Init":     2,
+		"2Way":     3,
+		"ExStart":  4,
+		"Exchange": 5,
+		"Loading":  6,
+		"Full":     7,
+	}
+
+	interfaceStates = map[string]int{
+		"Down":     0,
+		"Loopback": 1,
+		"Waiting":  2,
+		"PtToPt":   3,
+		"DR":       4,
+		"BDR":      5,
+		"DRother":  6,
+	}
+)
+
+func init() {
+	l := []string{"target"}
+	upDesc = prometheus.NewDesc(prefix+"up", "OSPFv3 is up and running (1 = up)", l, nil)
+
+	l = append(l, "area")
+	neighborsDesc = prometheus.NewDesc(prefix+"neighbors_count", "Number of neighbors", l, nil)
+
+	l = append(l, "interface")
+	interfaceStateDesc = prometheus.NewDesc(prefix+"interface_state", "State of the OSPFv3 interface (0 = Down, 1 = Loopback, 2 = Waiting, 3 = PtToPt, 4 = DR, 5 = BDR, 6 = DRother)", l, nil)
+	interfaceNeighborCountDesc = prometheus.NewDesc(prefix+"interface_neighbors_count", "Number of neighbors on the interface", l, nil)
+
+	l = append(l, "neighbor_id", "neighbor_address")
+	neighborStateDesc = prometheus.NewDesc(prefix+"neighbor_state", "State of the OSPFv3 neighbor (0 = Down, 1 = Attempt, 2 = Init, 3 = 2Way, 4 = ExStart, 5 = Exchange, 6 = Loading, 7 = Full)", l, nil)
+}
+
+type ospf3Collector struct {
+}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &ospf3Collector{}
+}
+
+// Name returns the name of the collector
+func (*ospf3Collector) Name() string {
+	return "OSPFv3"
+}
+
+// Describe describes the metrics
+func (*ospf3Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- upDesc
+	ch <- neighborsDesc
+	ch <- neighborStateDesc
+	ch <- interfaceStateDesc
+	ch <- interfaceNeighborCountDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *ospf3Collector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	err := c.collectOverview(client, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
+	err = c.collectNeighbors(client, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
+	return c.collectInterfaces(client, ch, labelValues)
+}
+
+func (c *ospf3Collector) collectOverview(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = overviewResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show ospf3 overview", client), client), &x)
+	if err != nil {
+		return err
+	}
+
+	areas := x.Information.Overview.Areas
+
+	up := 0
+	if len(areas) > 0 {
+		up = 1
+	}
+
+	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, float64(up), labelValues...)
+
+	collector.ReportRecords(client, len(areas))
+	for _, a := range areas {
+		l := append(labelValues, a.Name)
+		ch <- prometheus.MustNewConstMetric(neighborsDesc, prometheus.GaugeValue, float64(a.Neighbors.NeighborsUp), l...)
+	}
+
+	return nil
+}
+
+func (c *ospf3Collector) collectNeighbors(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = neighborResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show ospf3 neighbor detail", client), client), &x)
+	if err != nil {
+		return err
+	}
+
+	collector.ReportRecords(client, len(x.Information.Neighbors))
+	for _, n := range x.Information.Neighbors {
+		l := append(labelValues, n.Area, n.InterfaceName, n.ID, n.Address)
+		ch <- prometheus.MustNewConstMetric(neighborStateDesc, prometheus.GaugeValue, float64(neighborStates[n.State]), l...)
+	}
+
+	return nil
+}
+
+func (c *ospf3Collector) collectInterfaces(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = interfaceResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem(collector.CommandForRoutingInstance("show ospf3 interface", client), client), &x)
+	if err != nil {
+		return err
+	}
+
+	collector.ReportRecords(client, len(x.Information.Interfaces))
+	for _, i := range x.Information.Interfaces {
+		l := append(labelValues, i.Area, i.Name)
+		ch <- prometheus.MustNewConstMetric(interfaceStateDesc, prometheus.GaugeValue, float64(interfaceStates[i.State]), l...)
+		ch <- prometheus.MustNewConstMetric(interfaceNeighborCountDesc, prometheus.GaugeValue, float64(i.NeighborCount), l...)
+	}
+
+	return nil
+}
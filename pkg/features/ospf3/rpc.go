@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+
+package ospf3
+
+type overviewResult struct {
+	Information struct {
+		Overview struct {
+			Areas []area `xml:"ospf-area-overview"`
+		} `xml:"ospf-overview"`
+	} `xml:"ospf3-overview-information"`
+}
+
+type area struct {
+	Name      string `xml:"ospf-area"`
+	Neighbors struct {
+		NeighborsUp int64 `xml:"ospf-nbr-up-count"`
+	} `xml:"ospf-nbr-overview"`
+}
+
+type neighborResult struct {
+	Information struct {
+		Neighbors []neighbor `xml:"ospf3-neighbor"`
+	} `xml:"ospf3-neighbor-information"`
+}
+
+type neighbor struct {
+	ID            string `xml:"neighbor-id"`
+	Address       string `xml:"neighbor-address"`
+	InterfaceName string `xml:"interface-name"`
+	State         string `xml:"ospf-neighbor-state"`
+	Area          string `xml:"ospf-area"`
+	Priority      int64  `xml:"neighbor-priority"`
+}
+
+type interfaceResult struct {
+	Information struct {
+		Interfaces []iface `xml:"ospf3-interface"`
+	} `xml:"ospf3-interface-information"`
+}
+
+type iface struct {
+	Name          string `xml:"interface-name"`
+	State         string `xml:"ospf-interface-state"`
+	Area          string `xml:"ospf-area"`
+	NeighborCount int64  `xml:"neighbor-count"`
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+
+package ospf3
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNeighbors(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R3/junos">
+		<ospf3-neighbor-information xmlns="http://xml.juniper.net/junos/21.2R3/junos-routing">
+			<ospf3-neighbor>
+				<neighbor-id>192.0.2.2</neighbor-id>
+				<interface-name>xe-0/0/0.0</interface-name>
+				<ospf-neighbor-state>Full</ospf-neighbor-state>
+				<activity-timer>35</activity-timer>
+				<neighbor-priority>128</neighbor-priority>
+				<neighbor-address>fe80::1</neighbor-address>
+				<ospf-area>0.0.0.0</ospf-area>
+			</ospf3-neighbor>
+			<ospf3-neighbor>
+				<neighbor-id>192.0.2.3</neighbor-id>
+				<interface-name>xe-0/0/1.0</interface-name>
+				<ospf-neighbor-state>ExStart</ospf-neighbor-state>
+				<neighbor-priority>1</neighbor-priority>
+				<neighbor-address>fe80::2</neighbor-address>
+				<ospf-area>0.0.0.1</ospf-area>
+			</ospf3-neighbor>
+		</ospf3-neighbor-information>
+	</rpc-reply>`
+
+	rpc := neighborResult{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(rpc.Information.Neighbors), "neighbors")
+
+	n := rpc.Information.Neighbors[0]
+	assert.Equal(t, "192.0.2.2", n.ID, "neighbor-id")
+	assert.Equal(t, "xe-0/0/0.0", n.InterfaceName, "interface-name")
+	assert.Equal(t, "fe80::1", n.Address, "neighbor-address")
+	assert.Equal(t, "0.0.0.0", n.Area, "ospf-area")
+	assert.Equal(t, 7, neighborStates[n.State], "state")
+
+	n = rpc.Information.Neighbors[1]
+	assert.Equal(t, int64(1), n.Priority, "neighbor-priority")
+	assert.Equal(t, 4, neighborStates[n.State], "state")
+}
+
+func TestParseInterfaces(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.2R3/junos">
+		<ospf3-interface-information xmlns="http://xml.juniper.net/junos/21.2R3/junos-routing">
+			<ospf3-interface>
+				<interface-name>lo0.0</interface-name>
+				<ospf-interface-state>DR</ospf-interface-state>
+				<ospf-area>0.0.0.0</ospf-area>
+				<neighbor-count>0</neighbor-count>
+			</ospf3-interface>
+			<ospf3-interface>
+				<interface-name>xe-0/0/0.0</interface-name>
+				<ospf-interface-state>PtToPt</ospf-interface-state>
+				<ospf-area>0.0.0.0</ospf-area>
+				<neighbor-count>1</neighbor-count>
+			</ospf3-interface>
+		</ospf3-interface-information>
+	</rpc-reply>`
+
+	rpc := interfaceResult{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(rpc.Information.Interfaces), "interfaces")
+
+	i := rpc.Information.Interfaces[1]
+	assert.Equal(t, "xe-0/0/0.0", i.Name, "interface-name")
+	assert.Equal(t, int64(1), i.NeighborCount, "neighbor-count")
+	assert.Equal(t, 3, interfaceStates[i.State], "state")
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+
+package poe
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_poe_"
+
+var (
+	interfaceEnabledDesc    *prometheus.Desc
+	interfaceStatusDesc     *prometheus.Desc
+	interfacePowerDesc      *prometheus.Desc
+	interfacePowerLimitDesc *prometheus.Desc
+	controllerMaxPowerDesc  *prometheus.Desc
+	controllerPowerDesc     *prometheus.Desc
+	controllerGuardBandDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface"}
+	interfaceEnabledDesc = prometheus.NewDesc(prefix+"interface_enabled", "PoE is enabled on the interface (1 = enabled)", l, nil)
+	interfaceStatusDesc = prometheus.NewDesc(prefix+"interface_powered", "Interface is delivering power (1 = ON)", l, nil)
+	interfacePowerDesc = prometheus.NewDesc(prefix+"interface_power_watts", "Power drawn by the device connected to the interface", l, nil)
+	interfacePowerLimitDesc = prometheus.NewDesc(prefix+"interface_power_limit_watts", "Maximum power the interface can deliver", l, nil)
+
+	l = []string{"target", "controller"}
+	controllerMaxPowerDesc = prometheus.NewDesc(prefix+"controller_max_power_watts", "Power budget of the PoE controller", l, nil)
+	controllerPowerDesc = prometheus.NewDesc(prefix+"controller_power_consumption_watts", "Power consumed by all interfaces of the PoE controller", l, nil)
+	controllerGuardBandDesc = prometheus.NewDesc(prefix+"controller_guard_band_watts", "Power reserved by the PoE controller to prevent exceeding the budget", l, nil)
+}
+
+type poeCollector struct{}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &poeCollector{}
+}
+
+// Name returns the name of the collector
+func (*poeCollector) Name() string {
+	return "PoE"
+}
+
+// Describe describes the metrics
+func (*poeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- interfaceEnabledDesc
+	ch <- interfaceStatusDesc
+	ch <- interfacePowerDesc
+	ch <- interfacePowerLimitDesc
+	ch <- controllerMaxPowerDesc
+	ch <- controllerPowerDesc
+	ch <- controllerGuardBandDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *poeCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = controllerResult{}
+	err := client.RunCommandAndParse("show poe controller", &x)
+	if err != nil {
+		return err
+	}
+
+	for _, ctrl := range x.Information.Controllers {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strings.TrimSpace(ctrl.Number))
+		collectWatts(ch, controllerMaxPowerDesc, ctrl.MaximumPower, l)
+		collectWatts(ch, controllerPowerDesc, ctrl.PowerConsumption, l)
+		collectWatts(ch, controllerGuardBandDesc, ctrl.GuardBand, l)
+	}
+
+	var y = interfaceResult{}
+	err = client.RunCommandAndParse("show poe interface", &y)
+	if err != nil {
+		return err
+	}
+
+	for _, iface := range y.Information.Interfaces {
+		l := append(labelValues[:len(labelValues):len(labelValues)], strings.TrimSpace(iface.Name))
+		ch <- prometheus.MustNewConstMetric(interfaceEnabledDesc, prometheus.GaugeValue, boolToFloat(strings.EqualFold(strings.TrimSpace(iface.Enabled), "Enabled")), l...)
+		ch <- prometheus.MustNewConstMetric(interfaceStatusDesc, prometheus.GaugeValue, boolToFloat(strings.EqualFold(strings.TrimSpace(iface.Status), "ON")), l...)
+		collectWatts(ch, interfacePowerDesc, iface.Power, l)
+		collectWatts(ch, interfacePowerLimitDesc, iface.PowerLimit, l)
+	}
+
+	return nil
+}
+
+func collectWatts(ch chan<- prometheus.Metric, desc *prometheus.Desc, value string, labelValues []string) {
+	w, ok := parseWatts(value)
+	if !ok {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, w, labelValues...)
+}
+
+// parseWatts parses power values as reported by Junos (e.g. "15.4W")
+func parseWatts(s string) (float64, bool) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "W")
+	w, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return w, true
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
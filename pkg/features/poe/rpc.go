@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+
+package poe
+
+type interfaceResult struct {
+	Information struct {
+		Interfaces []poeInterface `xml:"interface-information"`
+	} `xml:"poe-interface-information"`
+}
+
+type poeInterface struct {
+	Name       string `xml:"interface-name"`
+	Enabled    string `xml:"interface-enabled"`
+	Status     string `xml:"interface-status"`
+	PowerLimit string `xml:"interface-power-limit"`
+	Priority   string `xml:"interface-priority"`
+	Power      string `xml:"interface-power"`
+	Class      string `xml:"interface-class"`
+}
+
+type controllerResult struct {
+	Information struct {
+		Controllers []poeController `xml:"controller-information"`
+	} `xml:"poe-controller-information"`
+}
+
+type poeController struct {
+	Number           string `xml:"controller-number"`
+	MaximumPower     string `xml:"controller-maximum-power"`
+	PowerConsumption string `xml:"controller-power-consumption"`
+	GuardBand        string `xml:"controller-guard-band"`
+	Management       string `xml:"controller-management"`
+	Status           string `xml:"controller-status"`
+}
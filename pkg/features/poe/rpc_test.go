@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+
+package poe
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePoEInterfaces(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<poe-interface-information>
+    <interface-information>
+        <interface-name>ge-0/0/0</interface-name>
+        <interface-enabled>Enabled</interface-enabled>
+        <interface-status>ON</interface-status>
+        <interface-power-limit>30.0W</interface-power-limit>
+        <interface-lldp-negotiation-power>N/A</interface-lldp-negotiation-power>
+        <interface-priority>Low</interface-priority>
+        <interface-power>6.4W</interface-power>
+        <interface-class>4</interface-class>
+    </interface-information>
+    <interface-information>
+        <interface-name>ge-0/0/1</interface-name>
+        <interface-enabled>Enabled</interface-enabled>
+        <interface-status>searching</interface-status>
+        <interface-power-limit>30.0W</interface-power-limit>
+        <interface-priority>Low</interface-priority>
+        <interface-power>0.0W</interface-power>
+        <interface-class>not-applicable</interface-class>
+    </interface-information>
+</poe-interface-information>
+</rpc-reply>`
+
+	var x interfaceResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Interfaces), "interfaces")
+
+	i := x.Information.Interfaces[0]
+	assert.Equal(t, "ge-0/0/0", i.Name, "name")
+	assert.Equal(t, "Enabled", i.Enabled, "enabled")
+	assert.Equal(t, "ON", i.Status, "status")
+
+	w, ok := parseWatts(i.Power)
+	assert.True(t, ok)
+	assert.Equal(t, 6.4, w, "power")
+
+	w, ok = parseWatts(i.PowerLimit)
+	assert.True(t, ok)
+	assert.Equal(t, 30.0, w, "power limit")
+
+	assert.Equal(t, "searching", x.Information.Interfaces[1].Status, "status")
+}
+
+func TestParsePoEController(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<poe-controller-information>
+    <controller-information>
+        <controller-number>0</controller-number>
+        <controller-maximum-power>370.0W</controller-maximum-power>
+        <controller-power-consumption>25.20W</controller-power-consumption>
+        <controller-guard-band>0W</controller-guard-band>
+        <controller-management>Static</controller-management>
+        <controller-status>AT_MODE</controller-status>
+    </controller-information>
+</poe-controller-information>
+</rpc-reply>`
+
+	var x controllerResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.Controllers), "controllers")
+
+	c := x.Information.Controllers[0]
+	assert.Equal(t, "0", c.Number, "number")
+
+	w, ok := parseWatts(c.MaximumPower)
+	assert.True(t, ok)
+	assert.Equal(t, 370.0, w, "maximum power")
+
+	w, ok = parseWatts(c.PowerConsumption)
+	assert.True(t, ok)
+	assert.Equal(t, 25.2, w, "power consumption")
+
+	_, ok = parseWatts("N/A")
+	assert.False(t, ok, "invalid value")
+}
@@ -4,6 +4,7 @@ package power
 
 import (
 	"encoding/xml"
+	"fmt"
 	"strings"
 
 	"github.com/czerwonk/junos_exporter/pkg/collector"
@@ -26,6 +27,8 @@ var (
 	dcCurrentDesc            *prometheus.Desc
 	dcVoltageDesc            *prometheus.Desc
 	dcLoadDesc               *prometheus.Desc
+	fpcPowerDesc             *prometheus.Desc
+	picPowerDesc             *prometheus.Desc
 )
 
 func init() {
@@ -48,14 +51,19 @@ func init() {
 	dcLoadDesc = prometheus.NewDesc(prefix+"pem_power_load_percent", "PEM power usage percent of total", l, nil)
 
 	pemPowerStateDesc = prometheus.NewDesc(prefix+"pem_power_state", "PEM power state. 1 - Online, 2 - Present, 3 - Empty", append(l, "state"), nil)
+
+	l = []string{"target", "re_name", "slot"}
+	fpcPowerDesc = prometheus.NewDesc("junos_fpc_power_watts", "Power consumed by the FPC (sum of all zones), in watts", l, nil)
+	picPowerDesc = prometheus.NewDesc("junos_pic_power_watts", "Power consumed by the PIC (sum of all zones), in watts", append(l, "pic"), nil)
 }
 
 type powerCollector struct {
+	fruPower bool
 }
 
-// NewCollector creates a new collector
-func NewCollector() collector.RPCCollector {
-	return &powerCollector{}
+// NewCollector creates a new collector, fruPower enables the power consumption per FPC and PIC (show chassis power detail)
+func NewCollector(fruPower bool) collector.RPCCollector {
+	return &powerCollector{fruPower: fruPower}
 }
 
 // Name returns the name of the collector
@@ -64,7 +72,7 @@ func (*powerCollector) Name() string {
 }
 
 // Describe describes the metrics
-func (*powerCollector) Describe(ch chan<- *prometheus.Desc) {
+func (c *powerCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- pemPowerStateDesc
 	ch <- capacityActualDesc
 	ch <- capacityMaxDesc
@@ -78,6 +86,11 @@ func (*powerCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- dcCurrentDesc
 	ch <- dcVoltageDesc
 	ch <- dcLoadDesc
+
+	if c.fruPower {
+		ch <- fpcPowerDesc
+		ch <- picPowerDesc
+	}
 }
 
 // Collect collects metrics from JunOS
@@ -88,8 +101,13 @@ func (c *powerCollector) Collect(client collector.Client, ch chan<- prometheus.M
 		"Empty":   3,
 	}
 
+	cmd := "show chassis power"
+	if c.fruPower {
+		cmd += " detail"
+	}
+
 	var x = multiRoutingEngineResult{}
-	err := client.RunCommandAndParseWithParser("show chassis power", func(b []byte) error {
+	err := client.RunCommandAndParseWithParser(cmd, func(b []byte) error {
 		return parseXML(b, &x)
 	})
 	if err != nil {
@@ -128,11 +146,52 @@ func (c *powerCollector) Collect(client collector.Client, ch chan<- prometheus.M
 
 			ch <- prometheus.MustNewConstMetric(pemPowerStateDesc, prometheus.GaugeValue, float64(stateValues[p.State]), append(pl, p.State)...)
 		}
+
+		if c.fruPower {
+			collectFRUPower(re.PowerUsageInformation.PowerUsageFRUItem, ch, l)
+		}
 	}
 
 	return nil
 }
 
+type picKey struct {
+	slot string
+	pic  string
+}
+
+// collectFRUPower sums up the power used per FPC and PIC, a FRU fed by redundant zones is listed once per zone
+func collectFRUPower(items []powerUsageFRUItem, ch chan<- prometheus.Metric, labelValues []string) {
+	fpcs := make(map[string]float64)
+	pics := make(map[picKey]float64)
+
+	for _, item := range items {
+		var slot, pic string
+		if _, err := fmt.Sscanf(item.Name, "FPC %s PIC %s", &slot, &pic); err == nil {
+			pics[picKey{slot: slot, pic: pic}] += item.PowerUsed
+			continue
+		}
+
+		if _, err := fmt.Sscanf(item.Name, "PIC %s", &slot); err == nil && strings.Contains(slot, "/") {
+			s := strings.SplitN(slot, "/", 2)
+			pics[picKey{slot: s[0], pic: s[1]}] += item.PowerUsed
+			continue
+		}
+
+		if _, err := fmt.Sscanf(item.Name, "FPC %s", &slot); err == nil {
+			fpcs[slot] += item.PowerUsed
+		}
+	}
+
+	for slot, watts := range fpcs {
+		ch <- prometheus.MustNewConstMetric(fpcPowerDesc, prometheus.GaugeValue, watts, append(labelValues, slot)...)
+	}
+
+	for k, watts := range pics {
+		ch <- prometheus.MustNewConstMetric(picPowerDesc, prometheus.GaugeValue, watts, append(labelValues, k.slot, k.pic)...)
+	}
+}
+
 func parseXML(b []byte, res *multiRoutingEngineResult) error {
 	if strings.Contains(string(b), "multi-routing-engine-results") {
 		return xml.Unmarshal(b, res)
@@ -33,6 +33,13 @@ type powerUsageInformation struct {
 		CapacitySysMax       int `xml:"capacity-sys-max"`
 		CapacitySysRemaining int `xml:"capacity-sys-remaining"`
 	} `xml:"power-usage-system"`
+	PowerUsageFRUItem []powerUsageFRUItem `xml:"power-usage-fru-information>power-usage-fru-item"`
+}
+
+type powerUsageFRUItem struct {
+	Name      string  `xml:"fru-name"`
+	Zone      string  `xml:"zone"`
+	PowerUsed float64 `xml:"power-used"`
 }
 
 type powerUsageItem struct {
@@ -5,6 +5,8 @@ package power
 import (
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -334,3 +336,60 @@ func TestParseNoMultiREOutputMX(t *testing.T) {
 	assert.Equal(t, int(4880), rpc.Results.RoutingEngine[0].PowerUsageInformation.PowerUsageSystem.CapacitySysMax, "capacity-sys-max")
 	assert.Equal(t, int(2895), rpc.Results.RoutingEngine[0].PowerUsageInformation.PowerUsageSystem.CapacitySysRemaining, "capacity-sys-remaining")
 }
+
+func TestParseDetailFRUPower(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/18.4R3/junos">
+    <power-usage-information>
+        <power-usage-fru-information>
+            <power-usage-fru-item>
+                <fru-name>FPC 0</fru-name>
+                <zone>0</zone>
+                <power-used>250</power-used>
+            </power-usage-fru-item>
+            <power-usage-fru-item>
+                <fru-name>FPC 0</fru-name>
+                <zone>1</zone>
+                <power-used>190.5</power-used>
+            </power-usage-fru-item>
+            <power-usage-fru-item>
+                <fru-name>FPC 0 PIC 1</fru-name>
+                <zone>0</zone>
+                <power-used>35</power-used>
+            </power-usage-fru-item>
+            <power-usage-fru-item>
+                <fru-name>Routing Engine 0</fru-name>
+                <zone>0</zone>
+                <power-used>90</power-used>
+            </power-usage-fru-item>
+        </power-usage-fru-information>
+    </power-usage-information>
+</rpc-reply>`
+
+	rpc := multiRoutingEngineResult{}
+	err := parseXML([]byte(body), &rpc)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := rpc.Results.RoutingEngine[0].PowerUsageInformation.PowerUsageFRUItem
+	assert.Equal(t, 4, len(items))
+	assert.Equal(t, "FPC 0", items[1].Name, "fru-name")
+	assert.Equal(t, "1", items[1].Zone, "zone")
+	assert.Equal(t, 190.5, items[1].PowerUsed, "power-used")
+
+	ch := make(chan prometheus.Metric, 10)
+	collectFRUPower(items, ch, []string{"router1", "N/A"})
+	close(ch)
+
+	values := make(map[string]float64)
+	for m := range ch {
+		d := &dto.Metric{}
+		assert.NoError(t, m.Write(d))
+		values[m.Desc().String()] = d.GetGauge().GetValue()
+	}
+
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, 440.5, values[fpcPowerDesc.String()], "FPC summed over zones")
+	assert.Equal(t, float64(35), values[picPowerDesc.String()], "PIC")
+}
@@ -53,11 +53,12 @@ func (*routeCollector) Describe(ch chan<- *prometheus.Desc) {
 // Collect collects metrics from JunOS
 func (c *routeCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
 	var x = result{}
-	err := client.RunCommandAndParse("show route summary", &x)
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show route summary", client), &x)
 	if err != nil {
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.Tables))
 	for _, t := range x.Information.Tables {
 		c.collectForTable(t, ch, labelValues)
 	}
@@ -66,14 +67,14 @@ func (c *routeCollector) Collect(client collector.Client, ch chan<- prometheus.M
 }
 
 func (c *routeCollector) collectForTable(table routeTable, ch chan<- prometheus.Metric, labelValues []string) {
-	l := append(labelValues, table.Name)
+	l := append(labelValues[:len(labelValues):len(labelValues)], table.Name)
 
 	ch <- prometheus.MustNewConstMetric(totalRoutesDesc, prometheus.GaugeValue, float64(table.TotalRoutes), l...)
 	ch <- prometheus.MustNewConstMetric(activeRoutesDesc, prometheus.GaugeValue, float64(table.ActiveRoutes), l...)
 	ch <- prometheus.MustNewConstMetric(maxRoutesDesc, prometheus.GaugeValue, float64(table.MaxRoutes), l...)
 
 	for _, proto := range table.Protocols {
-		lp := append(l, proto.Name)
+		lp := append(l[:len(l):len(l)], proto.Name)
 		ch <- prometheus.MustNewConstMetric(protocolRoutes, prometheus.GaugeValue, float64(proto.Routes), lp...)
 		ch <- prometheus.MustNewConstMetric(protocolActiveRoutes, prometheus.GaugeValue, float64(proto.ActiveRoutes), lp...)
 	}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+package route
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRouteSummary(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<route-summary-information xmlns="http://xml.juniper.net/junos/21.4R3/junos-routing">
+    <as-number>64496</as-number>
+    <route-table>
+        <table-name>inet.0</table-name>
+        <destination-count>912345</destination-count>
+        <total-route-count>1824690</total-route-count>
+        <active-route-count>912345</active-route-count>
+        <holddown-route-count>0</holddown-route-count>
+        <hidden-route-count>12</hidden-route-count>
+        <protocols>
+            <protocol-name>Direct</protocol-name>
+            <protocol-route-count>4</protocol-route-count>
+            <active-route-count>4</active-route-count>
+        </protocols>
+        <protocols>
+            <protocol-name>Static</protocol-name>
+            <protocol-route-count>2</protocol-route-count>
+            <active-route-count>1</active-route-count>
+        </protocols>
+        <protocols>
+            <protocol-name>BGP</protocol-name>
+            <protocol-route-count>1824684</protocol-route-count>
+            <active-route-count>912340</active-route-count>
+        </protocols>
+    </route-table>
+    <route-table>
+        <table-name>CUSTOMER.inet.0</table-name>
+        <prefix-max>1000</prefix-max>
+        <total-route-count>10</total-route-count>
+        <active-route-count>9</active-route-count>
+        <protocols>
+            <protocol-name>OSPF</protocol-name>
+            <protocol-route-count>10</protocol-route-count>
+            <active-route-count>9</active-route-count>
+        </protocols>
+    </route-table>
+</route-summary-information>
+</rpc-reply>`
+
+	var x result
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Tables), "table count")
+
+	tbl := x.Information.Tables[0]
+	assert.Equal(t, "inet.0", tbl.Name, "table-name")
+	assert.Equal(t, int64(1824690), tbl.TotalRoutes, "total-route-count")
+	assert.Equal(t, int64(912345), tbl.ActiveRoutes, "active-route-count")
+	assert.Equal(t, 3, len(tbl.Protocols), "protocol count")
+	assert.Equal(t, "BGP", tbl.Protocols[2].Name, "protocol-name")
+	assert.Equal(t, int64(1824684), tbl.Protocols[2].Routes, "protocol-route-count")
+	assert.Equal(t, int64(912340), tbl.Protocols[2].ActiveRoutes, "active-route-count")
+
+	tbl = x.Information.Tables[1]
+	assert.Equal(t, "CUSTOMER.inet.0", tbl.Name, "table-name")
+	assert.Equal(t, int64(1000), tbl.MaxRoutes, "prefix-max")
+}
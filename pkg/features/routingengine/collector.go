@@ -54,6 +54,10 @@ var (
 	memoryDataPlaneUsed    *prometheus.Desc
 	mastershipState        *prometheus.Desc
 	mastershipPriority     *prometheus.Desc
+
+	cpuPercent    *prometheus.Desc
+	memoryPercent *prometheus.Desc
+	master        *prometheus.Desc
 )
 
 func init() {
@@ -99,6 +103,10 @@ func init() {
 	memoryDataPlane = prometheus.NewDesc(prefix+"memory_data_plane_bytes", "Total Data Plane memory", l, nil)
 	memoryDataPlaneUsed = prometheus.NewDesc(prefix+"memory_data_plane_used_bytes", "Data Plane memory utilized", l, nil)
 
+	master = prometheus.NewDesc(prefix+"master", "Routing Engine is master (1 master, 0 backup or disabled)", l, nil)
+	memoryPercent = prometheus.NewDesc("junos_re_memory_percent", "Percent of Routing Engine memory being used", l, nil)
+	cpuPercent = prometheus.NewDesc("junos_re_cpu_percent", "Percent of Routing Engine CPU time by mode (5sec)", append(l, "mode"), nil)
+
 	l = []string{"target", "re_name", "slot", "mastership"}
 	mastershipState = prometheus.NewDesc(prefix+"mastership_state", "Mastership state", l, nil)
 	mastershipPriority = prometheus.NewDesc(prefix+"mastership_priority", "Mastership priority", l, nil)
@@ -160,6 +168,9 @@ func (*routingEngineCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- memoryDataPlaneUsed
 	ch <- mastershipState
 	ch <- mastershipPriority
+	ch <- cpuPercent
+	ch <- memoryPercent
+	ch <- master
 }
 
 // Collect collects metrics from JunOS
@@ -199,6 +210,13 @@ func (c *routingEngineCollector) collectForSlot(re routeEngine, ch chan<- promet
 	ch <- prometheus.MustNewConstMetric(cpuInterrupt, prometheus.GaugeValue, re.CPUInterrupt, l...)
 	ch <- prometheus.MustNewConstMetric(cpuIdle, prometheus.GaugeValue, re.CPUIdle, l...)
 
+	ch <- prometheus.MustNewConstMetric(cpuPercent, prometheus.GaugeValue, re.CPUUser, append(l, "user")...)
+	ch <- prometheus.MustNewConstMetric(cpuPercent, prometheus.GaugeValue, re.CPUBackground, append(l, "background")...)
+	ch <- prometheus.MustNewConstMetric(cpuPercent, prometheus.GaugeValue, re.CPUSystem, append(l, "system")...)
+	ch <- prometheus.MustNewConstMetric(cpuPercent, prometheus.GaugeValue, re.CPUInterrupt, append(l, "interrupt")...)
+	ch <- prometheus.MustNewConstMetric(cpuPercent, prometheus.GaugeValue, re.CPUIdle, append(l, "idle")...)
+	ch <- prometheus.MustNewConstMetric(memoryPercent, prometheus.GaugeValue, memoryPercentForEngine(re), l...)
+
 	if (re.CPUUser1 + re.CPUBackground1 + re.CPUSystem1 + re.CPUInterrupt1 + re.CPUIdle1) > 0 {
 		ch <- prometheus.MustNewConstMetric(cpuUser1, prometheus.GaugeValue, re.CPUUser1, l...)
 		ch <- prometheus.MustNewConstMetric(cpuBackground1, prometheus.GaugeValue, re.CPUBackground1, l...)
@@ -258,6 +276,12 @@ func (c *routingEngineCollector) collectForSlot(re routeEngine, ch chan<- promet
 
 	if re.MastershipState != "" {
 		ch <- prometheus.MustNewConstMetric(mastershipState, prometheus.GaugeValue, float64(1), append(l, re.MastershipState)...)
+
+		isMaster := 0
+		if isMasterState(re.MastershipState) {
+			isMaster = 1
+		}
+		ch <- prometheus.MustNewConstMetric(master, prometheus.GaugeValue, float64(isMaster), l...)
 	}
 
 	if re.MastershipPriority != "" {
@@ -267,6 +291,21 @@ func (c *routingEngineCollector) collectForSlot(re routeEngine, ch chan<- promet
 	return nil
 }
 
+// isMasterState returns if the mastership state is the one of the master RE (newer releases report primary instead of master)
+func isMasterState(state string) bool {
+	state = strings.ToLower(strings.TrimSpace(state))
+	return state == "master" || state == "primary"
+}
+
+// memoryPercentForEngine returns the utilization of the system memory if reported (e.g. SRX), otherwise the memory buffer utilization
+func memoryPercentForEngine(re routeEngine) float64 {
+	if re.MemorySystemTotalUtil > 0 {
+		return re.MemorySystemTotalUtil
+	}
+
+	return re.MemoryUtilization
+}
+
 func parseXML(b []byte, res *multiEngineResult) error {
 	if strings.Contains(string(b), "multi-routing-engine-results") {
 		return xml.Unmarshal(b, res)
@@ -57,6 +57,7 @@ type routeEngine struct {
 
 	MemorySystemTotal      float64 `xml:"memory-system-total,omitempty"`
 	MemorySystemTotalUsed  float64 `xml:"memory-system-total-used,omitempty"`
+	MemorySystemTotalUtil  float64 `xml:"memory-system-total-util,omitempty"`
 	MemoryControlPlane     float64 `xml:"memory-control-plane,omitempty"`
 	MemoryControlPlaneUsed float64 `xml:"memory-control-plane-used,omitempty"`
 	MemoryDataPlane        float64 `xml:"memory-data-plane,omitempty"`
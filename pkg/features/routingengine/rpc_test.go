@@ -127,6 +127,8 @@ func TestParseOutputMultiRE(t *testing.T) {
 	assert.Equal(t, float64(1905), rpc.Results.RoutingEngines[0].Information.RouteEngines[0].MemorySystemTotal, "memory-system-total")
 
 	assert.Equal(t, float64(19), rpc.Results.RoutingEngines[0].Information.RouteEngines[0].CPUUser1, "cpu-user1")
+
+	assert.Equal(t, float64(35), memoryPercentForEngine(rpc.Results.RoutingEngines[0].Information.RouteEngines[0]), "memory percent (system total util)")
 	// test routing engine 1
 	assert.Equal(t, "node1", rpc.Results.RoutingEngines[1].Name, "re-name")
 
@@ -222,9 +224,22 @@ func TestParseOutputNoMultiRE(t *testing.T) {
 
 	assert.Equal(t, float64(31), rpc.Results.RoutingEngines[0].Information.RouteEngines[0].CPUTemperature.Value, "cpu-temperature")
 
+	assert.Equal(t, float64(11), memoryPercentForEngine(rpc.Results.RoutingEngines[0].Information.RouteEngines[0]), "memory percent (buffer utilization)")
+
 	// test second route engine
 	assert.Equal(t, "1", rpc.Results.RoutingEngines[0].Information.RouteEngines[1].Slot, "slot")
 
 	assert.Equal(t, uint64(3149860), rpc.Results.RoutingEngines[0].Information.RouteEngines[1].UpTime.Seconds, "up-time")
 
+	assert.True(t, isMasterState(rpc.Results.RoutingEngines[0].Information.RouteEngines[0].MastershipState), "re0 master")
+	assert.False(t, isMasterState(rpc.Results.RoutingEngines[0].Information.RouteEngines[1].MastershipState), "re1 backup")
+
+}
+
+func TestIsMasterState(t *testing.T) {
+	assert.True(t, isMasterState("master"))
+	assert.True(t, isMasterState("Primary"))
+	assert.False(t, isMasterState("backup"))
+	assert.False(t, isMasterState("disabled"))
+	assert.False(t, isMasterState(""))
 }
@@ -13,6 +13,7 @@ var (
 	// Session metrics
 	upDesc              *prometheus.Desc
 	flapsDesc           *prometheus.Desc
+	uptimeDesc          *prometheus.Desc
 	ipv4PrefixCountDesc *prometheus.Desc
 	ipv6PrefixCountDesc *prometheus.Desc
 
@@ -21,12 +22,16 @@ var (
 	originResultsValidDesc   *prometheus.Desc
 	originResultsInvalidDesc *prometheus.Desc
 	originResultsUnknownDesc *prometheus.Desc
+	recordCountDesc          *prometheus.Desc
+	prefixCountDesc          *prometheus.Desc
+	originASCountDesc        *prometheus.Desc
 )
 
 func init() {
 	lSession := []string{"target", "ip"}
-	upDesc = prometheus.NewDesc(prefix+"session_state", "Session is (0 = Down, 1 = Up, 2 = Connect, 3 = Ex-Incr, 4 = Ex-Start, 5 = Ex-Full)", lSession, nil)
+	upDesc = prometheus.NewDesc(prefix+"session_state", "Session is (0 = Down, 1 = Up, 2 = Connect, 3 = Ex-Start, 4 = Ex-Incr, 5 = Ex-Full)", lSession, nil)
 	flapsDesc = prometheus.NewDesc(prefix+"session_flap_count", "Number of session flaps", lSession, nil)
+	uptimeDesc = prometheus.NewDesc(prefix+"session_uptime_seconds", "Time since the session to the validator was established in seconds", lSession, nil)
 	ipv4PrefixCountDesc = prometheus.NewDesc(prefix+"session_ipv4_prefix_count", "Number of IPv4 route validation records", lSession, nil)
 	ipv6PrefixCountDesc = prometheus.NewDesc(prefix+"session_ipv6_prefix_count", "Number of IPv6 route validation records", lSession, nil)
 
@@ -36,6 +41,9 @@ func init() {
 	originResultsValidDesc = prometheus.NewDesc(stats_prefix+"origin_valid", "Origin validation result of valid", lStats, nil)
 	originResultsInvalidDesc = prometheus.NewDesc(stats_prefix+"origin_invalid", "Origin validation result of invalid", lStats, nil)
 	originResultsUnknownDesc = prometheus.NewDesc(stats_prefix+"origin_unknown", "Origin validation result of unknown", lStats, nil)
+	recordCountDesc = prometheus.NewDesc(stats_prefix+"record_count", "Number of route validation records", lStats, nil)
+	prefixCountDesc = prometheus.NewDesc(stats_prefix+"prefix_count", "Number of prefixes in route validation records", lStats, nil)
+	originASCountDesc = prometheus.NewDesc(stats_prefix+"origin_as_count", "Number of origin ASes in route validation records", lStats, nil)
 }
 
 type rpkiCollector struct {
@@ -55,12 +63,16 @@ func NewCollector() collector.RPCCollector {
 func (*rpkiCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- upDesc
 	ch <- flapsDesc
+	ch <- uptimeDesc
 	ch <- ipv4PrefixCountDesc
 	ch <- ipv6PrefixCountDesc
 	ch <- memoryUtilizationDesc
 	ch <- originResultsValidDesc
 	ch <- originResultsInvalidDesc
 	ch <- originResultsUnknownDesc
+	ch <- recordCountDesc
+	ch <- prefixCountDesc
+	ch <- originASCountDesc
 }
 
 // Collect collects metrics from JunOS
@@ -85,6 +97,7 @@ func (c *rpkiCollector) collectSessions(client collector.Client, ch chan<- prome
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.Sessions))
 	for _, session := range x.Information.Sessions {
 		c.collectForSession(session, ch, labelValues)
 	}
@@ -125,6 +138,12 @@ func (c *rpkiCollector) collectForSession(s session, ch chan<- prometheus.Metric
 	ch <- prometheus.MustNewConstMetric(flapsDesc, prometheus.GaugeValue, float64(s.Flaps), l...)
 	ch <- prometheus.MustNewConstMetric(ipv4PrefixCountDesc, prometheus.GaugeValue, float64(s.IPv4PrefixCount), l...)
 	ch <- prometheus.MustNewConstMetric(ipv6PrefixCountDesc, prometheus.GaugeValue, float64(s.IPv6PrefixCount), l...)
+
+	if state == Up {
+		if uptime, err := s.uptimeSeconds(); err == nil {
+			ch <- prometheus.MustNewConstMetric(uptimeDesc, prometheus.GaugeValue, uptime, l...)
+		}
+	}
 }
 
 func (c *rpkiCollector) collectStatistics(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
@@ -139,6 +158,9 @@ func (c *rpkiCollector) collectStatistics(client collector.Client, ch chan<- pro
 	ch <- prometheus.MustNewConstMetric(originResultsValidDesc, prometheus.GaugeValue, float64(x.Information.Statistics.OriginResultsValid), labelValues...)
 	ch <- prometheus.MustNewConstMetric(originResultsInvalidDesc, prometheus.GaugeValue, float64(x.Information.Statistics.OriginResultsInvalid), labelValues...)
 	ch <- prometheus.MustNewConstMetric(originResultsUnknownDesc, prometheus.GaugeValue, float64(x.Information.Statistics.OriginResultsUnknown), labelValues...)
+	ch <- prometheus.MustNewConstMetric(recordCountDesc, prometheus.GaugeValue, float64(x.Information.Statistics.RecordCount), labelValues...)
+	ch <- prometheus.MustNewConstMetric(prefixCountDesc, prometheus.GaugeValue, float64(x.Information.Statistics.PrefixCount), labelValues...)
+	ch <- prometheus.MustNewConstMetric(originASCountDesc, prometheus.GaugeValue, float64(x.Information.Statistics.OriginASCount), labelValues...)
 
 	return nil
 }
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: MIT
+
+package rpki
+
+import "github.com/czerwonk/junos_exporter/pkg/parse"
+
+// uptimeSeconds returns the session uptime in seconds. If JunOS does not provide the seconds attribute
+// the uptime is parsed from its textual representation (e.g. "2w1d 03:04:05", "1d 03:04:05" or "03:04:05").
+func (s *session) uptimeSeconds() (float64, error) {
+	if s.Uptime.Seconds > 0 {
+		return float64(s.Uptime.Seconds), nil
+	}
+
+	return parse.Uptime(s.Uptime.Value)
+}
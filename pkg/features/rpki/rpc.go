@@ -9,11 +9,15 @@ type sessionResult struct {
 }
 
 type session struct {
-	IPAddress       string `xml:"ip-address"`
-	State           string `xml:"session-state"`
-	Flaps           int64  `xml:"session-flaps"`
-	IPv4PrefixCount int64  `xml:"ip-prefix-count"`
-	IPv6PrefixCount int64  `xml:"ip6-prefix-count"`
+	IPAddress string `xml:"ip-address"`
+	State     string `xml:"session-state"`
+	Flaps     int64  `xml:"session-flaps"`
+	Uptime    struct {
+		Value   string `xml:",chardata"`
+		Seconds uint64 `xml:"seconds,attr"`
+	} `xml:"session-uptime"`
+	IPv4PrefixCount int64 `xml:"ip-prefix-count"`
+	IPv6PrefixCount int64 `xml:"ip6-prefix-count"`
 }
 
 type statisticResult struct {
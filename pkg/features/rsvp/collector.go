@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+
+package rsvp
+
+import (
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/parse"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_rsvp_"
+
+var (
+	interfaceState              *prometheus.Desc
+	interfaceActiveReservations *prometheus.Desc
+	interfaceStaticBandwidth    *prometheus.Desc
+	interfaceAvailableBandwidth *prometheus.Desc
+	interfaceReservedBandwidth  *prometheus.Desc
+	neighborState               *prometheus.Desc
+	neighborUpCount             *prometheus.Desc
+	neighborDownCount           *prometheus.Desc
+	neighborHelloInterval       *prometheus.Desc
+	neighborHellosSent          *prometheus.Desc
+	neighborHellosReceived      *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface"}
+	interfaceState = prometheus.NewDesc(prefix+"interface_state", "State of RSVP on the interface (1 = Up, 0 = Down)", l, nil)
+	interfaceActiveReservations = prometheus.NewDesc(prefix+"interface_active_reservations", "Number of active reservations on the interface", l, nil)
+	interfaceStaticBandwidth = prometheus.NewDesc(prefix+"interface_static_bandwidth_bps", "Bandwidth available for RSVP on the interface in bits per second", l, nil)
+	interfaceAvailableBandwidth = prometheus.NewDesc(prefix+"interface_available_bandwidth_bps", "Bandwidth not yet reserved on the interface in bits per second", l, nil)
+	interfaceReservedBandwidth = prometheus.NewDesc(prefix+"interface_reserved_bandwidth_bps", "Bandwidth reserved on the interface in bits per second", l, nil)
+
+	l = []string{"target", "neighbor"}
+	neighborState = prometheus.NewDesc(prefix+"neighbor_state", "State of the RSVP neighbor (1 = Up, 0 = Down)", l, nil)
+	neighborUpCount = prometheus.NewDesc(prefix+"neighbor_up_count", "Number of times the RSVP neighbor went up", l, nil)
+	neighborDownCount = prometheus.NewDesc(prefix+"neighbor_down_count", "Number of times the RSVP neighbor went down", l, nil)
+	neighborHelloInterval = prometheus.NewDesc(prefix+"neighbor_hello_interval_seconds", "Hello interval of the RSVP neighbor in seconds", l, nil)
+	neighborHellosSent = prometheus.NewDesc(prefix+"neighbor_hellos_sent", "Number of hellos sent to the RSVP neighbor", l, nil)
+	neighborHellosReceived = prometheus.NewDesc(prefix+"neighbor_hellos_received", "Number of hellos received from the RSVP neighbor", l, nil)
+}
+
+type rsvpCollector struct {
+}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &rsvpCollector{}
+}
+
+// Name returns the name of the collector
+func (*rsvpCollector) Name() string {
+	return "RSVP"
+}
+
+// Describe describes the metrics
+func (*rsvpCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- interfaceState
+	ch <- interfaceActiveReservations
+	ch <- interfaceStaticBandwidth
+	ch <- interfaceAvailableBandwidth
+	ch <- interfaceReservedBandwidth
+	ch <- neighborState
+	ch <- neighborUpCount
+	ch <- neighborDownCount
+	ch <- neighborHelloInterval
+	ch <- neighborHellosSent
+	ch <- neighborHellosReceived
+}
+
+// Collect collects metrics from JunOS
+func (c *rsvpCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	err := c.collectInterfaces(client, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
+	return c.collectNeighbors(client, ch, labelValues)
+}
+
+func (c *rsvpCollector) collectInterfaces(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = interfaceResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show rsvp interface", client), &x)
+	if err != nil {
+		return err
+	}
+
+	collector.ReportRecords(client, len(x.Information.Interfaces))
+	for _, i := range x.Information.Interfaces {
+		l := append(labelValues[:len(labelValues):len(labelValues)], i.Name)
+		ch <- prometheus.MustNewConstMetric(interfaceState, prometheus.GaugeValue, upToFloat(i.Status), l...)
+		ch <- prometheus.MustNewConstMetric(interfaceActiveReservations, prometheus.GaugeValue, float64(i.TELink.ActiveReservations), l...)
+
+		if bw, err := parse.Bandwidth(i.TELink.StaticBandwidth); err == nil {
+			ch <- prometheus.MustNewConstMetric(interfaceStaticBandwidth, prometheus.GaugeValue, bw, l...)
+		}
+
+		if bw, err := parse.Bandwidth(i.TELink.AvailableBandwidth); err == nil {
+			ch <- prometheus.MustNewConstMetric(interfaceAvailableBandwidth, prometheus.GaugeValue, bw, l...)
+		}
+
+		if bw, err := parse.Bandwidth(i.TELink.ReservedBandwidth); err == nil {
+			ch <- prometheus.MustNewConstMetric(interfaceReservedBandwidth, prometheus.GaugeValue, bw, l...)
+		}
+	}
+
+	return nil
+}
+
+func (c *rsvpCollector) collectNeighbors(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = neighborResult{}
+	err := client.RunCommandAndParse(collector.CommandForLogicalSystem("show rsvp neighbor detail", client), &x)
+	if err != nil {
+		return err
+	}
+
+	collector.ReportRecords(client, len(x.Information.Neighbors))
+	for _, n := range x.Information.Neighbors {
+		l := append(labelValues[:len(labelValues):len(labelValues)], n.Address)
+		ch <- prometheus.MustNewConstMetric(neighborState, prometheus.GaugeValue, upToFloat(n.Status), l...)
+		ch <- prometheus.MustNewConstMetric(neighborUpCount, prometheus.GaugeValue, float64(n.UpCount), l...)
+		ch <- prometheus.MustNewConstMetric(neighborDownCount, prometheus.GaugeValue, float64(n.DownCount), l...)
+		ch <- prometheus.MustNewConstMetric(neighborHelloInterval, prometheus.GaugeValue, float64(n.HelloInterval), l...)
+		ch <- prometheus.MustNewConstMetric(neighborHellosSent, prometheus.GaugeValue, float64(n.HellosSent), l...)
+		ch <- prometheus.MustNewConstMetric(neighborHellosReceived, prometheus.GaugeValue, float64(n.HellosReceived), l...)
+	}
+
+	return nil
+}
+
+func upToFloat(s string) float64 {
+	if s == "Up" {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+
+package rsvp
+
+type interfaceResult struct {
+	Information struct {
+		Interfaces []rsvpInterface `xml:"rsvp-interface"`
+	} `xml:"rsvp-interface-information"`
+}
+
+type rsvpInterface struct {
+	Name   string `xml:"interface-name"`
+	Status string `xml:"rsvp-status"`
+	TELink struct {
+		ActiveReservations int64  `xml:"active-reservation"`
+		StaticBandwidth    string `xml:"static-bandwidth"`
+		AvailableBandwidth string `xml:"available-bandwidth"`
+		ReservedBandwidth  string `xml:"total-reserved-bandwidth"`
+	} `xml:"rsvp-telink"`
+}
+
+type neighborResult struct {
+	Information struct {
+		Neighbors []neighbor `xml:"rsvp-neighbor"`
+	} `xml:"rsvp-neighbor-information"`
+}
+
+type neighbor struct {
+	Address        string `xml:"rsvp-neighbor-address"`
+	Status         string `xml:"rsvp-neighbor-status"`
+	UpCount        int64  `xml:"neighbor-up-count"`
+	DownCount      int64  `xml:"neighbor-down-count"`
+	HelloInterval  int64  `xml:"hello-interval"`
+	HellosSent     int64  `xml:"hellos-sent"`
+	HellosReceived int64  `xml:"hellos-received"`
+}
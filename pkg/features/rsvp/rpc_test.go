@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+
+package rsvp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/parse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterfaces(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <rsvp-interface-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing">
+        <active-count>1</active-count>
+        <rsvp-interface>
+            <interface-name>ae0.0</interface-name>
+            <index>336</index>
+            <rsvp-status>Up</rsvp-status>
+            <rsvp-telink>
+                <active-reservation>3</active-reservation>
+                <subscription>100</subscription>
+                <static-bandwidth>10Gbps</static-bandwidth>
+                <available-bandwidth>9.5Gbps</available-bandwidth>
+                <total-reserved-bandwidth>500Mbps</total-reserved-bandwidth>
+                <high-watermark>500Mbps</high-watermark>
+            </rsvp-telink>
+        </rsvp-interface>
+    </rsvp-interface-information>
+</rpc-reply>`
+
+	var x interfaceResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Len(t, x.Information.Interfaces, 1)
+	i := x.Information.Interfaces[0]
+	assert.Equal(t, "ae0.0", i.Name)
+	assert.Equal(t, "Up", i.Status)
+	assert.Equal(t, int64(3), i.TELink.ActiveReservations)
+
+	bw, err := parse.Bandwidth(i.TELink.AvailableBandwidth)
+	assert.NoError(t, err)
+	assert.Equal(t, 9.5e9, bw)
+
+	bw, err = parse.Bandwidth(i.TELink.ReservedBandwidth)
+	assert.NoError(t, err)
+	assert.Equal(t, 500e6, bw)
+}
+
+func TestParseNeighbors(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <rsvp-neighbor-information xmlns="http://xml.juniper.net/junos/21.4R0/junos-routing">
+        <rsvp-neighbor-count>1</rsvp-neighbor-count>
+        <rsvp-neighbor>
+            <rsvp-neighbor-address>192.0.2.1</rsvp-neighbor-address>
+            <rsvp-neighbor-status>Up</rsvp-neighbor-status>
+            <neighbor-up-count>2</neighbor-up-count>
+            <neighbor-down-count>1</neighbor-down-count>
+            <hello-interval>9</hello-interval>
+            <hellos-sent>12345</hellos-sent>
+            <hellos-received>12340</hellos-received>
+        </rsvp-neighbor>
+    </rsvp-neighbor-information>
+</rpc-reply>`
+
+	var x neighborResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []neighbor{{
+		Address:        "192.0.2.1",
+		Status:         "Up",
+		UpCount:        2,
+		DownCount:      1,
+		HelloInterval:  9,
+		HellosSent:     12345,
+		HellosReceived: 12340,
+	}}, x.Information.Neighbors)
+}
@@ -20,6 +20,10 @@ var (
 	outputBytesDesc      *prometheus.Desc
 	inputPacketsDesc     *prometheus.Desc
 	outputPacketsDesc    *prometheus.Desc
+	hitsDesc             *prometheus.Desc
+	sessionsCurrentDesc  *prometheus.Desc
+	sessionsMaxDesc      *prometheus.Desc
+	sessionsFailedDesc   *prometheus.Desc
 )
 
 func init() {
@@ -27,6 +31,7 @@ func init() {
 	lb := []string{"target", "from_zone", "to_zone", "policy_name", "direction"}
 
 	hitCountDesc = prometheus.NewDesc(prefix+"hit_count", "Policy hit count", la, nil)
+	hitsDesc = prometheus.NewDesc("junos_security_policy_hits_total", "Number of hits of the policy", la, nil)
 
 	sessionCreationsDesc = prometheus.NewDesc(prefix+"session_creations", "Policy session creations", la, nil)
 	sessionDeletionsDesc = prometheus.NewDesc(prefix+"session_deletions", "Policy session deletions", la, nil)
@@ -35,6 +40,11 @@ func init() {
 	outputBytesDesc = prometheus.NewDesc(prefix+"output_bytes", "Policy output bytes", lb, nil)
 	inputPacketsDesc = prometheus.NewDesc(prefix+"input_packets", "Policy input packets", lb, nil)
 	outputPacketsDesc = prometheus.NewDesc(prefix+"output_packets", "Policy output packets", lb, nil)
+
+	l := []string{"target", "re_name"}
+	sessionsCurrentDesc = prometheus.NewDesc("junos_security_sessions_current", "Number of flow sessions in use", l, nil)
+	sessionsMaxDesc = prometheus.NewDesc("junos_security_sessions_max", "Maximum number of flow sessions supported", l, nil)
+	sessionsFailedDesc = prometheus.NewDesc("junos_security_sessions_failed_total", "Number of flow sessions which could not be created", l, nil)
 }
 
 type securityPolicyCollector struct {
@@ -59,6 +69,10 @@ func (*securityPolicyCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- outputBytesDesc
 	ch <- inputBytesDesc
 	ch <- outputBytesDesc
+	ch <- hitsDesc
+	ch <- sessionsCurrentDesc
+	ch <- sessionsMaxDesc
+	ch <- sessionsFailedDesc
 }
 
 // Collect collects metrics from JunOS
@@ -73,6 +87,11 @@ func (c *securityPolicyCollector) Collect(client collector.Client, ch chan<- pro
 		return err
 	}
 
+	err = c.CollectSessions(client, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -132,7 +151,27 @@ func (c *securityPolicyCollector) CollectHits(client collector.Client, ch chan<-
 	for _, pol := range x.MultiRoutingEngineResults.RoutingEngine[0].HitCount.Policies {
 		ls := append(labelValues, pol.FromZone, pol.ToZone, pol.PolicyName)
 		ch <- prometheus.MustNewConstMetric(hitCountDesc, prometheus.CounterValue, pol.Count, ls...)
+		ch <- prometheus.MustNewConstMetric(hitsDesc, prometheus.CounterValue, pol.Count, ls...)
+	}
+	return nil
+}
+
+func (c *securityPolicyCollector) CollectSessions(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = sessionsMultiEngineResult{}
+	err := client.RunCommandAndParseWithParser("show security flow session summary", func(b []byte) error {
+		return parseSessionsXML(b, &x)
+	})
+	if err != nil {
+		return err
 	}
+
+	for _, re := range x.MultiRoutingEngineResults.RoutingEngine {
+		l := append(labelValues[:len(labelValues):len(labelValues)], re.Name)
+		ch <- prometheus.MustNewConstMetric(sessionsCurrentDesc, prometheus.GaugeValue, re.Summary.ActiveSessions, l...)
+		ch <- prometheus.MustNewConstMetric(sessionsMaxDesc, prometheus.GaugeValue, re.Summary.MaxSessions, l...)
+		ch <- prometheus.MustNewConstMetric(sessionsFailedDesc, prometheus.CounterValue, re.Summary.FailedSessions, l...)
+	}
+
 	return nil
 }
 
@@ -179,3 +218,25 @@ func parseHitsXML(b []byte, res *hitsMultiEngineResult) error {
 
 	return nil
 }
+
+func parseSessionsXML(b []byte, res *sessionsMultiEngineResult) error {
+	if strings.Contains(string(b), "multi-routing-engine-results") {
+		return xml.Unmarshal(b, res)
+	}
+
+	fi := sessionsSingleEngineResult{}
+
+	err := xml.Unmarshal(b, &fi)
+	if err != nil {
+		return err
+	}
+
+	res.MultiRoutingEngineResults.RoutingEngine = []sessionsRoutingEngine{
+		{
+			Name:    "N/A",
+			Summary: fi.Summary,
+		},
+	}
+
+	return nil
+}
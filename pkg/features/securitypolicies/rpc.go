@@ -93,3 +93,30 @@ type hitCountEntry struct {
 	ToZone     string  `xml:"policy-hit-count-to-zone"`
 	Count      float64 `xml:"policy-hit-count-count"`
 }
+
+type sessionsMultiEngineResult struct {
+	XMLName                   xml.Name               `xml:"rpc-reply"`
+	MultiRoutingEngineResults sessionsRoutingEngines `xml:"multi-routing-engine-results"`
+}
+
+type sessionsRoutingEngines struct {
+	RoutingEngine []sessionsRoutingEngine `xml:"multi-routing-engine-item"`
+}
+
+type sessionsRoutingEngine struct {
+	Name    string         `xml:"re-name"`
+	Summary sessionSummary `xml:"flow-session-summary-information"`
+}
+
+type sessionsSingleEngineResult struct {
+	XMLName xml.Name       `xml:"rpc-reply"`
+	Summary sessionSummary `xml:"flow-session-summary-information"`
+}
+
+type sessionSummary struct {
+	UnicastSessions   float64 `xml:"active-unicast-sessions"`
+	MulticastSessions float64 `xml:"active-multicast-sessions"`
+	FailedSessions    float64 `xml:"failed-sessions"`
+	ActiveSessions    float64 `xml:"active-sessions"`
+	MaxSessions       float64 `xml:"max-sessions"`
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+
+package securitypolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSessionsSingleEngine(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<flow-session-summary-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-flow">
+    <active-unicast-sessions>1200</active-unicast-sessions>
+    <active-multicast-sessions>0</active-multicast-sessions>
+    <failed-sessions>17</failed-sessions>
+    <active-sessions>1250</active-sessions>
+    <active-session-valid>1200</active-session-valid>
+    <active-session-pending>0</active-session-pending>
+    <active-session-invalidated>50</active-session-invalidated>
+    <active-session-other>0</active-session-other>
+    <max-sessions>524288</max-sessions>
+</flow-session-summary-information>
+</rpc-reply>`
+
+	var x sessionsMultiEngineResult
+	err := parseSessionsXML([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.MultiRoutingEngineResults.RoutingEngine), "routing engine count")
+
+	re := x.MultiRoutingEngineResults.RoutingEngine[0]
+	assert.Equal(t, "N/A", re.Name, "re-name")
+	assert.Equal(t, float64(1250), re.Summary.ActiveSessions, "active-sessions")
+	assert.Equal(t, float64(524288), re.Summary.MaxSessions, "max-sessions")
+	assert.Equal(t, float64(17), re.Summary.FailedSessions, "failed-sessions")
+	assert.Equal(t, float64(1200), re.Summary.UnicastSessions, "active-unicast-sessions")
+}
+
+func TestParseSessionsMultiEngine(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+<multi-routing-engine-results>
+    <multi-routing-engine-item>
+        <re-name>node0</re-name>
+        <flow-session-summary-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-flow">
+            <failed-sessions>0</failed-sessions>
+            <active-sessions>300</active-sessions>
+            <max-sessions>1048576</max-sessions>
+        </flow-session-summary-information>
+    </multi-routing-engine-item>
+    <multi-routing-engine-item>
+        <re-name>node1</re-name>
+        <flow-session-summary-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-flow">
+            <failed-sessions>2</failed-sessions>
+            <active-sessions>298</active-sessions>
+            <max-sessions>1048576</max-sessions>
+        </flow-session-summary-information>
+    </multi-routing-engine-item>
+</multi-routing-engine-results>
+</rpc-reply>`
+
+	var x sessionsMultiEngineResult
+	err := parseSessionsXML([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.MultiRoutingEngineResults.RoutingEngine), "routing engine count")
+
+	re := x.MultiRoutingEngineResults.RoutingEngine[1]
+	assert.Equal(t, "node1", re.Name, "re-name")
+	assert.Equal(t, float64(298), re.Summary.ActiveSessions, "active-sessions")
+	assert.Equal(t, float64(1048576), re.Summary.MaxSessions, "max-sessions")
+	assert.Equal(t, float64(2), re.Summary.FailedSessions, "failed-sessions")
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+
+package softwareversion
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix string = "junos_"
+
+var (
+	versionInfoDesc *prometheus.Desc
+	releaseRegex    = regexp.MustCompile(`\[([^\]]+)\]`)
+)
+
+func init() {
+	l := []string{"target", "re_name", "hostname", "model", "version"}
+	versionInfoDesc = prometheus.NewDesc(prefix+"version_info", "Software version and model of the device (always 1)", l, nil)
+}
+
+type versionCollector struct {
+}
+
+// NewCollector creates a new collector
+func NewCollector() collector.RPCCollector {
+	return &versionCollector{}
+}
+
+// Name returns the name of the collector
+func (*versionCollector) Name() string {
+	return "Version"
+}
+
+// Describe describes the metrics
+func (*versionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- versionInfoDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *versionCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = multiEngineResult{}
+	err := client.RunCommandAndParseWithParser("show version", func(b []byte) error {
+		return parseXML(b, &x)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, re := range x.Results.RoutingEngines {
+		s := re.SoftwareInformation
+		l := append(labelValues[:len(labelValues):len(labelValues)], re.Name, s.HostName, s.ProductModel, s.version())
+		ch <- prometheus.MustNewConstMetric(versionInfoDesc, prometheus.GaugeValue, 1, l...)
+	}
+
+	return nil
+}
+
+// version returns the JunOS version. Older releases only provide the version in the comment of the junos package.
+func (s *softwareInformation) version() string {
+	if len(s.JunosVersion) > 0 {
+		return s.JunosVersion
+	}
+
+	for _, p := range s.Packages {
+		if p.Name != "junos" {
+			continue
+		}
+
+		m := releaseRegex.FindStringSubmatch(p.Comment)
+		if m != nil {
+			return m[1]
+		}
+	}
+
+	return ""
+}
+
+func parseXML(b []byte, res *multiEngineResult) error {
+	if strings.Contains(string(b), "multi-routing-engine-results") {
+		return xml.Unmarshal(b, res)
+	}
+
+	fi := singleEngineResult{}
+
+	err := xml.Unmarshal(b, &fi)
+	if err != nil {
+		return err
+	}
+
+	res.Results.RoutingEngines = []routingEngine{
+		{
+			Name:                "N/A",
+			SoftwareInformation: fi.SoftwareInformation,
+		},
+	}
+
+	return nil
+}
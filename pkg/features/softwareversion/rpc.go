@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+
+package softwareversion
+
+import "encoding/xml"
+
+type multiEngineResult struct {
+	XMLName xml.Name           `xml:"rpc-reply"`
+	Results multiEngineResults `xml:"multi-routing-engine-results"`
+}
+
+type multiEngineResults struct {
+	RoutingEngines []routingEngine `xml:"multi-routing-engine-item"`
+}
+
+type routingEngine struct {
+	Name                string              `xml:"re-name"`
+	SoftwareInformation softwareInformation `xml:"software-information"`
+}
+
+type singleEngineResult struct {
+	XMLName             xml.Name            `xml:"rpc-reply"`
+	SoftwareInformation softwareInformation `xml:"software-information"`
+}
+
+type softwareInformation struct {
+	HostName     string               `xml:"host-name"`
+	ProductModel string               `xml:"product-model"`
+	JunosVersion string               `xml:"junos-version"`
+	Packages     []packageInformation `xml:"package-information"`
+}
+
+type packageInformation struct {
+	Name    string `xml:"name"`
+	Comment string `xml:"comment"`
+}
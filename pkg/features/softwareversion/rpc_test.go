@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+
+package softwareversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSingleEngine(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R0/junos">
+    <software-information>
+        <host-name>router1</host-name>
+        <product-model>mx204</product-model>
+        <product-name>mx204</product-name>
+        <junos-version>21.4R3-S4.9</junos-version>
+        <package-information>
+            <name>os-kernel</name>
+            <comment>JUNOS OS Kernel 64-bit  [20230105.c2a8d2b_builder_stable_12]</comment>
+        </package-information>
+    </software-information>
+</rpc-reply>`
+
+	var x multiEngineResult
+	err := parseXML([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Len(t, x.Results.RoutingEngines, 1)
+	s := x.Results.RoutingEngines[0].SoftwareInformation
+	assert.Equal(t, "router1", s.HostName)
+	assert.Equal(t, "mx204", s.ProductModel)
+	assert.Equal(t, "21.4R3-S4.9", s.version())
+}
+
+func TestParseMultiEngineLegacy(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/15.1R0/junos">
+    <multi-routing-engine-results>
+        <multi-routing-engine-item>
+            <re-name>re0</re-name>
+            <software-information>
+                <host-name>router2-re0</host-name>
+                <product-model>mx480</product-model>
+                <package-information>
+                    <name>junos</name>
+                    <comment>JUNOS Base OS boot [15.1R7.9]</comment>
+                </package-information>
+            </software-information>
+        </multi-routing-engine-item>
+        <multi-routing-engine-item>
+            <re-name>re1</re-name>
+            <software-information>
+                <host-name>router2-re1</host-name>
+                <product-model>mx480</product-model>
+                <package-information>
+                    <name>junos</name>
+                    <comment>JUNOS Base OS boot [15.1R7.9]</comment>
+                </package-information>
+            </software-information>
+        </multi-routing-engine-item>
+    </multi-routing-engine-results>
+</rpc-reply>`
+
+	var x multiEngineResult
+	err := parseXML([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Len(t, x.Results.RoutingEngines, 2)
+	assert.Equal(t, "re1", x.Results.RoutingEngines[1].Name)
+	assert.Equal(t, "router2-re1", x.Results.RoutingEngines[1].SoftwareInformation.HostName)
+	assert.Equal(t, "15.1R7.9", x.Results.RoutingEngines[0].SoftwareInformation.version())
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+
+package stormcontrol
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const prefix = "junos_storm_control_"
+
+var (
+	stateDesc    *prometheus.Desc
+	triggersDesc *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target", "interface", "profile"}
+	stateDesc = prometheus.NewDesc(prefix+"state", "Storm control state of the interface (0: normal, 1: triggered)", l, nil)
+	triggersDesc = prometheus.NewDesc(prefix+"triggers_total", "Number of times storm control was observed triggering on the interface since the exporter started", l, nil)
+}
+
+type stormControlCollector struct {
+	triggers *Triggers
+}
+
+// NewCollector creates a new collector counting the triggers in triggers
+func NewCollector(triggers *Triggers) collector.RPCCollector {
+	return &stormControlCollector{triggers: triggers}
+}
+
+// Name returns the name of the collector
+func (*stormControlCollector) Name() string {
+	return "Storm Control"
+}
+
+// Describe describes the metrics
+func (*stormControlCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- stateDesc
+	ch <- triggersDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *stormControlCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show ethernet-switching interface detail", &x)
+	if err != nil {
+		return err
+	}
+
+	states := make(map[string]bool)
+	for _, iface := range x.Information.Interfaces {
+		if len(iface.Profile) == 0 {
+			// storm control not configured
+			continue
+		}
+
+		states[iface.Name] = isTriggered(iface.Status)
+	}
+
+	counts := c.triggers.record(client.Device().Host, states)
+	for _, iface := range x.Information.Interfaces {
+		if len(iface.Profile) == 0 {
+			continue
+		}
+
+		state := 0.0
+		if states[iface.Name] {
+			state = 1
+		}
+
+		l := append(labelValues[:len(labelValues):len(labelValues)], iface.Name, iface.Profile)
+		ch <- prometheus.MustNewConstMetric(stateDesc, prometheus.GaugeValue, state, l...)
+		ch <- prometheus.MustNewConstMetric(triggersDesc, prometheus.CounterValue, counts[iface.Name], l...)
+	}
+
+	return nil
+}
+
+// isTriggered returns if the status indicates that traffic is dropped or the interface was shut down by storm control
+func isTriggered(status string) bool {
+	s := strings.ToLower(strings.TrimSpace(status))
+	return len(s) > 0 && s != "normal" && s != "none"
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: MIT
+
+package stormcontrol
+
+type result struct {
+	Information struct {
+		Interfaces []stormControlInterface `xml:"l2ng-l2ald-iff-interface-entry"`
+	} `xml:"l2ng-l2ald-iff-interface-information"`
+}
+
+type stormControlInterface struct {
+	Name    string `xml:"l2iff-interface-name"`
+	Profile string `xml:"l2iff-interface-storm-control-profile"`
+	Status  string `xml:"l2iff-interface-storm-control-status"`
+}
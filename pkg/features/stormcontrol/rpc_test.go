@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+
+package stormcontrol
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStormControl(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+		<l2ng-l2ald-iff-interface-information>
+			<l2ng-l2ald-iff-interface-entry>
+				<l2iff-interface-name>ge-0/0/1.0</l2iff-interface-name>
+				<l2iff-interface-storm-control-profile>default</l2iff-interface-storm-control-profile>
+				<l2iff-interface-storm-control-status>Normal</l2iff-interface-storm-control-status>
+			</l2ng-l2ald-iff-interface-entry>
+			<l2ng-l2ald-iff-interface-entry>
+				<l2iff-interface-name>ge-0/0/2.0</l2iff-interface-name>
+				<l2iff-interface-storm-control-profile>default</l2iff-interface-storm-control-profile>
+				<l2iff-interface-storm-control-status>Shutdown</l2iff-interface-storm-control-status>
+			</l2ng-l2ald-iff-interface-entry>
+			<l2ng-l2ald-iff-interface-entry>
+				<l2iff-interface-name>ge-0/0/3.0</l2iff-interface-name>
+			</l2ng-l2ald-iff-interface-entry>
+		</l2ng-l2ald-iff-interface-information>
+	</rpc-reply>`
+
+	rpc := result{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 3, len(rpc.Information.Interfaces), "interfaces")
+	assert.False(t, isTriggered(rpc.Information.Interfaces[0].Status), "normal")
+	assert.True(t, isTriggered(rpc.Information.Interfaces[1].Status), "shutdown")
+	assert.Empty(t, rpc.Information.Interfaces[2].Profile, "not configured")
+}
+
+func TestTriggers(t *testing.T) {
+	tr := NewTriggers()
+	assert.Equal(t, float64(0), tr.record("sw1", map[string]bool{"ge-0/0/1.0": false})["ge-0/0/1.0"])
+	assert.Equal(t, float64(1), tr.record("sw1", map[string]bool{"ge-0/0/1.0": true})["ge-0/0/1.0"])
+	assert.Equal(t, float64(1), tr.record("sw1", map[string]bool{"ge-0/0/1.0": true})["ge-0/0/1.0"])
+	assert.Equal(t, float64(1), tr.record("sw1", map[string]bool{"ge-0/0/1.0": false})["ge-0/0/1.0"])
+	assert.Equal(t, float64(2), tr.record("sw1", map[string]bool{"ge-0/0/1.0": true})["ge-0/0/1.0"])
+	assert.Equal(t, float64(0), tr.record("sw2", map[string]bool{"ge-0/0/1.0": false})["ge-0/0/1.0"])
+
+	tr.record("sw1", map[string]bool{"ge-0/0/2.0": false})
+	assert.Equal(t, float64(1), tr.record("sw1", map[string]bool{"ge-0/0/1.0": true})["ge-0/0/1.0"], "interface state dropped when missing")
+
+	tr.Prune(func(target string) bool {
+		return target == "sw2"
+	})
+	assert.NotContains(t, tr.targets, "sw1")
+	assert.Contains(t, tr.targets, "sw2")
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+
+package stormcontrol
+
+import "sync"
+
+// Triggers counts the transitions into the triggered state per target and interface, JunOS does not provide a counter itself.
+// The counts have to outlive a scrape, so they are kept by the exporter and passed to the collector.
+type Triggers struct {
+	mu      sync.Mutex
+	targets map[string]map[string]*interfaceState
+}
+
+type interfaceState struct {
+	triggered bool
+	count     float64
+}
+
+// NewTriggers creates an empty set of trigger counts
+func NewTriggers() *Triggers {
+	return &Triggers{
+		targets: make(map[string]map[string]*interfaceState),
+	}
+}
+
+// record stores the states of the interfaces of target and returns the number of triggers per interface.
+// Interfaces missing in states (e.g. storm control was removed from the interface) are dropped.
+func (t *Triggers) record(target string, states map[string]bool) map[string]float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	last := t.targets[target]
+	current := make(map[string]*interfaceState, len(states))
+	counts := make(map[string]float64, len(states))
+	for iface, triggered := range states {
+		s, found := last[iface]
+		if !found {
+			s = &interfaceState{}
+		}
+
+		if triggered && !s.triggered {
+			s.count++
+		}
+		s.triggered = triggered
+
+		current[iface] = s
+		counts[iface] = s.count
+	}
+
+	t.targets[target] = current
+	return counts
+}
+
+// Prune drops the counts of all targets known returns false for (e.g. devices removed from the config on reload)
+func (t *Triggers) Prune(known func(target string) bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for target := range t.targets {
+		if !known(target) {
+			delete(t.targets, target)
+		}
+	}
+}
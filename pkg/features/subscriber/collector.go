@@ -3,17 +3,31 @@
 package subscriber
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/czerwonk/junos_exporter/pkg/collector"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 const prefix string = "junos_subscriber_info"
 
-var subscriberInfoDesc *prometheus.Desc
+const summaryPrefix string = "junos_subscriber_"
+
+var (
+	subscriberInfoDesc  *prometheus.Desc
+	clientTypeCountDesc *prometheus.Desc
+	stateCountDesc      *prometheus.Desc
+	interfaceCountDesc  *prometheus.Desc
+)
 
 func init() {
 	l := []string{"target", "interface", "agent_circuit_id", "agent_remote_id"}
 	subscriberInfoDesc = prometheus.NewDesc(prefix+"", "Subscriber Detail", l, nil)
+
+	clientTypeCountDesc = prometheus.NewDesc(summaryPrefix+"sessions_count", "Number of subscriber sessions by client type", []string{"target", "client_type"}, nil)
+	stateCountDesc = prometheus.NewDesc(summaryPrefix+"sessions_state_count", "Number of subscriber sessions by session state", []string{"target", "state"}, nil)
+	interfaceCountDesc = prometheus.NewDesc(summaryPrefix+"interface_sessions_count", "Number of subscriber sessions per interface", []string{"target", "interface"}, nil)
 }
 
 // Name implements collector.RPCCollector.
@@ -29,6 +43,9 @@ func NewCollector() collector.RPCCollector {
 // Describe describes the metrics
 func (*subcsribers_information) Describe(ch chan<- *prometheus.Desc) {
 	ch <- subscriberInfoDesc
+	ch <- clientTypeCountDesc
+	ch <- stateCountDesc
+	ch <- interfaceCountDesc
 }
 
 // Collect collects metrics from JunOS
@@ -39,10 +56,76 @@ func (c *subcsribers_information) Collect(client collector.Client, ch chan<- pro
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.SubscribersInformation.Subscriber))
 	for _, subscriber := range x.SubscribersInformation.Subscriber {
 		labels := append(labelValues, subscriber.Interface, subscriber.AgentCircuitID, subscriber.AgentRemoteID)
 		ch <- prometheus.MustNewConstMetric(subscriberInfoDesc, prometheus.CounterValue, 1, labels...)
 	}
 
+	return c.collectSummary(client, ch, labelValues)
+}
+
+func (c *subcsribers_information) collectSummary(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = subscribersSummaryResult{}
+	err := client.RunCommandAndParse("show subscribers summary", &x)
+	if err != nil {
+		return err
+	}
+
+	for _, counters := range x.Information.Counters {
+		switch counters.Style {
+		case "client-type":
+			collectSummaryCounters(ch, clientTypeCountDesc, "session-client-type-", counters, labelValues)
+		case "state":
+			collectSummaryCounters(ch, stateCountDesc, "session-state-", counters, labelValues)
+		}
+	}
+
+	var p = subscribersSummaryResult{}
+	err = client.RunCommandAndParse("show subscribers summary port", &p)
+	if err != nil {
+		return err
+	}
+
+	for _, counters := range p.Information.Counters {
+		if counters.Style != "port" {
+			continue
+		}
+
+		port := ""
+		for _, v := range counters.Values {
+			switch v.XMLName.Local {
+			case "port-name":
+				port = strings.TrimSpace(v.Value)
+			case "port-count":
+				count, err := strconv.ParseFloat(strings.TrimSpace(v.Value), 64)
+				if err != nil || port == "" {
+					continue
+				}
+
+				l := append(labelValues[:len(labelValues):len(labelValues)], port)
+				ch <- prometheus.MustNewConstMetric(interfaceCountDesc, prometheus.GaugeValue, count, l...)
+			}
+		}
+	}
+
 	return nil
 }
+
+// collectSummaryCounters exports the counters named <namePrefix><value> (e.g. session-client-type-dhcp), omitting the total
+func collectSummaryCounters(ch chan<- prometheus.Metric, desc *prometheus.Desc, namePrefix string, counters summaryCounters, labelValues []string) {
+	for _, v := range counters.Values {
+		name := strings.TrimPrefix(v.XMLName.Local, namePrefix)
+		if name == v.XMLName.Local || name == "total" {
+			continue
+		}
+
+		count, err := strconv.ParseFloat(strings.TrimSpace(v.Value), 64)
+		if err != nil {
+			continue
+		}
+
+		l := append(labelValues[:len(labelValues):len(labelValues)], name)
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, count, l...)
+	}
+}
@@ -1,5 +1,7 @@
 package subscriber
 
+import "encoding/xml"
+
 type subcsribers_information struct {
 	SubscribersInformation struct {
 		Subscriber []subscriber `xml:"subscriber"`
@@ -12,3 +14,19 @@ type subscriber struct {
 	AgentCircuitID string `xml:"agent-circuit-id"`
 	AgentRemoteID  string `xml:"agent-remote-id"`
 }
+
+type subscribersSummaryResult struct {
+	Information struct {
+		Counters []summaryCounters `xml:"counters"`
+	} `xml:"subscribers-summary-information"`
+}
+
+type summaryCounters struct {
+	Style  string         `xml:"style,attr"`
+	Values []summaryValue `xml:",any"`
+}
+
+type summaryValue struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+
+package subscriber
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSubscribersSummary(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<subscribers-summary-information>
+    <counters junos:style="state">
+        <session-state-init>1</session-state-init>
+        <session-state-configured>0</session-state-configured>
+        <session-state-active>42</session-state-active>
+        <session-state-terminating>2</session-state-terminating>
+        <session-state-total>45</session-state-total>
+    </counters>
+    <counters junos:style="client-type">
+        <session-client-type-dhcp>30</session-client-type-dhcp>
+        <session-client-type-pppoe>15</session-client-type-pppoe>
+        <session-client-type-total>45</session-client-type-total>
+    </counters>
+</subscribers-summary-information>
+</rpc-reply>`
+
+	var x subscribersSummaryResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(x.Information.Counters), "counters")
+
+	state := x.Information.Counters[0]
+	assert.Equal(t, "state", state.Style, "style")
+	assert.Equal(t, 5, len(state.Values), "state values")
+	assert.Equal(t, "session-state-active", state.Values[2].XMLName.Local, "name")
+	assert.Equal(t, "42", state.Values[2].Value, "value")
+
+	clientType := x.Information.Counters[1]
+	assert.Equal(t, "client-type", clientType.Style, "style")
+	assert.Equal(t, "session-client-type-pppoe", clientType.Values[1].XMLName.Local, "name")
+	assert.Equal(t, "15", clientType.Values[1].Value, "value")
+}
+
+func TestParseSubscribersSummaryPort(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+<subscribers-summary-information>
+    <counters junos:style="port">
+        <port-name>ge-1/0/0</port-name>
+        <port-count>30</port-count>
+        <port-name>ge-1/0/1</port-name>
+        <port-count>15</port-count>
+        <port-total>45</port-total>
+    </counters>
+</subscribers-summary-information>
+</rpc-reply>`
+
+	var x subscribersSummaryResult
+	err := xml.Unmarshal([]byte(body), &x)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(x.Information.Counters), "counters")
+	assert.Equal(t, "port", x.Information.Counters[0].Style, "style")
+	assert.Equal(t, 5, len(x.Information.Counters[0].Values), "port values")
+	assert.Equal(t, "ge-1/0/1", x.Information.Counters[0].Values[2].Value, "port name")
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"strings"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+const prefix string = "junos_system_"
+
+var (
+	activeUsersDesc *prometheus.Desc
+	sessionsDesc    *prometheus.Desc
+)
+
+func init() {
+	l := []string{"target"}
+	activeUsersDesc = prometheus.NewDesc(prefix+"users_active_count", "Number of users logged in as reported by the device", l, nil)
+	sessionsDesc = prometheus.NewDesc(prefix+"user_sessions_count", "Number of login sessions by login class of the user and type of terminal (empty class if unknown)", append(l, "class", "tty_type"), nil)
+}
+
+type usersCollector struct {
+	loginClasses bool
+}
+
+type sessionKey struct {
+	class   string
+	ttyType string
+}
+
+// NewCollector creates a new collector, if loginClasses is set the login class of the users is taken from the configuration (show configuration system login)
+func NewCollector(loginClasses bool) collector.RPCCollector {
+	return &usersCollector{loginClasses: loginClasses}
+}
+
+// Name returns the name of the collector
+func (*usersCollector) Name() string {
+	return "Users"
+}
+
+// Describe describes the metrics
+func (*usersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeUsersDesc
+	ch <- sessionsDesc
+}
+
+// Collect collects metrics from JunOS
+func (c *usersCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	var x = result{}
+	err := client.RunCommandAndParse("show system users", &x)
+	if err != nil {
+		return err
+	}
+
+	ch <- prometheus.MustNewConstMetric(activeUsersDesc, prometheus.GaugeValue, float64(x.Information.Uptime.ActiveUserCount), labelValues...)
+
+	classes := map[string]string{}
+	if c.loginClasses {
+		classes = c.loginClassesFromConfig(client)
+	}
+
+	sessions := make(map[sessionKey]int)
+	for _, u := range x.Information.Uptime.Users {
+		k := sessionKey{
+			class:   classForUser(strings.TrimSpace(u.User), classes),
+			ttyType: ttyType(strings.TrimSpace(u.TTY)),
+		}
+		sessions[k]++
+	}
+
+	for k, count := range sessions {
+		l := append(labelValues[:len(labelValues):len(labelValues)], k.class, k.ttyType)
+		ch <- prometheus.MustNewConstMetric(sessionsDesc, prometheus.GaugeValue, float64(count), l...)
+	}
+
+	return nil
+}
+
+// loginClassesFromConfig returns the login class of the configured users. If the configuration can not be viewed (e.g. missing permissions) an empty map is returned.
+func (c *usersCollector) loginClassesFromConfig(client collector.Client) map[string]string {
+	var x = loginResult{}
+	err := client.RunCommandAndParse("show configuration system login", &x)
+	if err != nil {
+		log.Warnf("could not retrieve login classes from %s: %v", client.Device().Host, err)
+		return map[string]string{}
+	}
+
+	classes := make(map[string]string)
+	for _, u := range x.Configuration.Users {
+		classes[strings.TrimSpace(u.Name)] = strings.TrimSpace(u.Class)
+	}
+
+	return classes
+}
+
+func classForUser(user string, classes map[string]string) string {
+	if class, found := classes[user]; found {
+		return class
+	}
+
+	if user == "root" {
+		return "super-user"
+	}
+
+	return ""
+}
+
+// ttyType strips the number of the terminal (e.g. pts/0 => pts, u0 => u)
+func ttyType(tty string) string {
+	if i := strings.Index(tty, "/"); i > 0 {
+		return tty[:i]
+	}
+
+	return strings.TrimRight(tty, "0123456789")
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+
+package users
+
+type result struct {
+	Information struct {
+		Uptime struct {
+			ActiveUserCount int64       `xml:"active-user-count"`
+			Users           []userEntry `xml:"user-table>user-entry"`
+		} `xml:"uptime-information"`
+	} `xml:"system-users-information"`
+}
+
+type userEntry struct {
+	User string `xml:"user"`
+	TTY  string `xml:"tty"`
+	From string `xml:"from"`
+}
+
+type loginResult struct {
+	Configuration struct {
+		Users []struct {
+			Name  string `xml:"name"`
+			Class string `xml:"class"`
+		} `xml:"system>login>user"`
+	} `xml:"configuration"`
+}
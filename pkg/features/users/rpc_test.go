@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+
+package users
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSystemUsers(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+    <system-users-information xmlns="http://xml.juniper.net/junos/21.4R3/junos">
+        <uptime-information>
+            <date-time junos:seconds="1700000000">10:13AM</date-time>
+            <up-time junos:seconds="8553600">99 days</up-time>
+            <active-user-count junos:format="3 users">3</active-user-count>
+            <user-table>
+                <user-entry>
+                    <user>root</user>
+                    <tty>u0</tty>
+                    <from>-</from>
+                    <login-time junos:seconds="1699990000">Tue07AM</login-time>
+                    <idle-time junos:seconds="0">-</idle-time>
+                    <command>cli</command>
+                </user-entry>
+                <user-entry>
+                    <user>noc</user>
+                    <tty>pts/0</tty>
+                    <from>192.0.2.1</from>
+                    <login-time junos:seconds="1699999000">10:00AM</login-time>
+                    <idle-time junos:seconds="0">-</idle-time>
+                    <command>-cl</command>
+                </user-entry>
+                <user-entry>
+                    <user>noc</user>
+                    <tty>pts/1</tty>
+                    <from>192.0.2.2</from>
+                    <login-time junos:seconds="1699999500">10:05AM</login-time>
+                    <idle-time junos:seconds="60">1</idle-time>
+                    <command>-cl</command>
+                </user-entry>
+            </user-table>
+        </uptime-information>
+    </system-users-information>
+</rpc-reply>`
+
+	x := result{}
+	err := xml.Unmarshal([]byte(body), &x)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, int64(3), x.Information.Uptime.ActiveUserCount, "active-user-count")
+	assert.Equal(t, 3, len(x.Information.Uptime.Users))
+	assert.Equal(t, "noc", x.Information.Uptime.Users[1].User, "user")
+	assert.Equal(t, "pts/0", x.Information.Uptime.Users[1].TTY, "tty")
+	assert.Equal(t, "192.0.2.1", x.Information.Uptime.Users[1].From, "from")
+}
+
+func TestParseLoginConfiguration(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/21.4R3/junos">
+    <configuration junos:commit-seconds="1699000000">
+        <system>
+            <login>
+                <user>
+                    <name>noc</name>
+                    <uid>2001</uid>
+                    <class>read-only</class>
+                </user>
+                <user>
+                    <name>admin</name>
+                    <uid>2002</uid>
+                    <class>super-user</class>
+                </user>
+            </login>
+        </system>
+    </configuration>
+</rpc-reply>`
+
+	x := loginResult{}
+	err := xml.Unmarshal([]byte(body), &x)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(x.Configuration.Users))
+	assert.Equal(t, "noc", x.Configuration.Users[0].Name, "name")
+	assert.Equal(t, "read-only", x.Configuration.Users[0].Class, "class")
+}
+
+func TestClassForUser(t *testing.T) {
+	classes := map[string]string{"noc": "read-only"}
+
+	assert.Equal(t, "read-only", classForUser("noc", classes))
+	assert.Equal(t, "super-user", classForUser("root", classes))
+	assert.Equal(t, "", classForUser("unknown", classes))
+}
+
+func TestTTYType(t *testing.T) {
+	assert.Equal(t, "pts", ttyType("pts/3"))
+	assert.Equal(t, "u", ttyType("u0"))
+	assert.Equal(t, "d", ttyType("d0"))
+}
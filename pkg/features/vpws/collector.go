@@ -59,6 +59,7 @@ func (c *vpwsCollector) Collect(client collector.Client, ch chan<- prometheus.Me
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.VpwsInstances))
 	for _, vInst := range x.Information.VpwsInstances {
 		for _, vIf := range vInst.Interfaces {
 			l := append(labelValues, vInst.Name, vInst.RD, vIf.Name, vIf.Esi, vIf.Mode, vIf.Role)
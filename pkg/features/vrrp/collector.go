@@ -10,12 +10,16 @@ import (
 const prefix = "junos_vrrp_"
 
 var (
-	vrrpState *prometheus.Desc
+	vrrpState                 *prometheus.Desc
+	vrrpPriority              *prometheus.Desc
+	vrrpAdvertisementInterval *prometheus.Desc
 )
 
 func init() {
 	l := []string{"target", "interface", "group", "local_interface_address", "virtual_ip_address"}
 	vrrpState = prometheus.NewDesc(prefix+"state", "VRRP state (1: init, 2: backup, 3: master)", l, nil)
+	vrrpPriority = prometheus.NewDesc(prefix+"priority", "Current VRRP priority of the router", l, nil)
+	vrrpAdvertisementInterval = prometheus.NewDesc(prefix+"advertisement_interval_seconds", "VRRP advertisement interval in seconds", l, nil)
 }
 
 type vrrpCollector struct {
@@ -34,6 +38,8 @@ func NewCollector() collector.RPCCollector {
 // Describe describes the metrics
 func (*vrrpCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- vrrpState
+	ch <- vrrpPriority
+	ch <- vrrpAdvertisementInterval
 }
 
 // Collect collects metrics from JunOS
@@ -45,15 +51,24 @@ func (c *vrrpCollector) Collect(client collector.Client, ch chan<- prometheus.Me
 	}
 
 	var x = result{}
-	err := client.RunCommandAndParse("show vrrp summary", &x)
+	err := client.RunCommandAndParse("show vrrp detail", &x)
 	if err != nil {
 		return err
 	}
 
+	collector.ReportRecords(client, len(x.Information.Interfaces))
 	for _, iface := range x.Information.Interfaces {
 		l := labelValues
 		l = append(l, iface.Interface, iface.Group, iface.LocalInterfaceAddress, iface.VirtualIPAddress)
 		ch <- prometheus.MustNewConstMetric(vrrpState, prometheus.GaugeValue, float64(statusValues[iface.VrrpState]), l...)
+
+		if p, err := iface.priority(); err == nil {
+			ch <- prometheus.MustNewConstMetric(vrrpPriority, prometheus.GaugeValue, p, l...)
+		}
+
+		if i, err := iface.advertisementInterval(); err == nil {
+			ch <- prometheus.MustNewConstMetric(vrrpAdvertisementInterval, prometheus.GaugeValue, i, l...)
+		}
 	}
 
 	return nil
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+
+package vrrp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// priority returns the current priority of the group (falling back to the configured one)
+func (i *iface) priority() (float64, error) {
+	p := i.CurrentPriority
+	if len(p) == 0 {
+		p = i.ConfiguredPriority
+	}
+
+	return strconv.ParseFloat(strings.TrimSpace(p), 64)
+}
+
+// advertisementInterval returns the advertisement interval in seconds.
+// JunOS reports seconds by default and milliseconds for VRRPv3/fast intervals (e.g. "100 milliseconds").
+func (i *iface) advertisementInterval() (float64, error) {
+	fields := strings.Fields(i.AdvertisementInterval)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("no advertisement interval")
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "ms"), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.HasSuffix(fields[0], "ms") || (len(fields) > 1 && strings.HasPrefix(fields[1], "milli")) {
+		return v / 1000, nil
+	}
+
+	return v, nil
+}
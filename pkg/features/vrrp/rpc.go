@@ -16,4 +16,7 @@ type iface struct {
 	VrrpMode              string `xml:"vrrp-mode"`
 	LocalInterfaceAddress string `xml:"local-interface-address"`
 	VirtualIPAddress      string `xml:"virtual-ip-address"`
+	CurrentPriority       string `xml:"current-priority"`
+	ConfiguredPriority    string `xml:"configured-priority"`
+	AdvertisementInterval string `xml:"advertisement-interval"`
 }
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+
+package vrrp
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVRRPDetail(t *testing.T) {
+	body := `<rpc-reply xmlns:junos="http://xml.juniper.net/junos/20.4R3/junos">
+		<vrrp-information xmlns="http://xml.juniper.net/junos/20.4R3/junos-vrrpd">
+			<vrrp-interface>
+				<interface>ge-0/0/1.100</interface>
+				<interface-state>up</interface-state>
+				<group>1</group>
+				<vrrp-state>master</vrrp-state>
+				<vrrp-mode>Active</vrrp-mode>
+				<local-interface-address>192.0.2.2</local-interface-address>
+				<virtual-ip-address>192.0.2.1</virtual-ip-address>
+				<current-priority>200</current-priority>
+				<configured-priority>200</configured-priority>
+				<advertisement-interval>1</advertisement-interval>
+			</vrrp-interface>
+			<vrrp-interface>
+				<interface>ge-0/0/1.100</interface>
+				<interface-state>up</interface-state>
+				<group>6</group>
+				<vrrp-state>backup</vrrp-state>
+				<vrrp-mode>Active</vrrp-mode>
+				<local-interface-address>2001:db8::2</local-interface-address>
+				<virtual-ip-address>2001:db8::1</virtual-ip-address>
+				<configured-priority>100</configured-priority>
+				<advertisement-interval>100 milliseconds</advertisement-interval>
+			</vrrp-interface>
+		</vrrp-information>
+	</rpc-reply>`
+
+	rpc := result{}
+	err := xml.Unmarshal([]byte(body), &rpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, len(rpc.Information.Interfaces), "interfaces")
+
+	v4 := rpc.Information.Interfaces[0]
+	assert.Equal(t, "1", v4.Group, "group")
+	assert.Equal(t, "master", v4.VrrpState, "vrrp-state")
+	assert.Equal(t, "192.0.2.1", v4.VirtualIPAddress, "virtual-ip-address")
+
+	p, err := v4.priority()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(200), p, "priority")
+
+	i, err := v4.advertisementInterval()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), i, "advertisement interval")
+
+	v6 := rpc.Information.Interfaces[1]
+	assert.Equal(t, "2001:db8::1", v6.VirtualIPAddress, "virtual-ip-address")
+	assert.Equal(t, "backup", v6.VrrpState, "vrrp-state")
+
+	p, err = v6.priority()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(100), p, "priority")
+
+	i, err = v6.advertisementInterval()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, i, "advertisement interval")
+}
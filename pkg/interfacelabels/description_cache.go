@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+
+package interfacelabels
+
+import (
+	"sync"
+	"time"
+)
+
+// DescriptionCache caches the interface descriptions per device, so they are not retrieved on every scrape
+type DescriptionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*descriptionCacheEntry
+}
+
+type descriptionCacheEntry struct {
+	interfaces []phyInterface
+	expires    time.Time
+}
+
+// NewDescriptionCache creates a cache keeping the descriptions of a device for the duration ttl
+func NewDescriptionCache(ttl time.Duration) *DescriptionCache {
+	return &DescriptionCache{
+		ttl:     ttl,
+		entries: make(map[string]*descriptionCacheEntry),
+	}
+}
+
+func (c *DescriptionCache) get(host string) ([]phyInterface, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.entries[host]
+	if found && time.Now().Before(e.expires) {
+		return e.interfaces, true
+	}
+
+	delete(c.entries, host)
+	return nil, false
+}
+
+func (c *DescriptionCache) set(host string, interfaces []phyInterface) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[host] = &descriptionCacheEntry{
+		interfaces: interfaces,
+		expires:    time.Now().Add(c.ttl),
+	}
+}
+
+// Reset drops all cached descriptions (e.g. after the config was reloaded)
+func (c *DescriptionCache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*descriptionCacheEntry)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+
+package interfacelabels
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescriptionCache(t *testing.T) {
+	c := NewDescriptionCache(time.Minute)
+	ifaces := []phyInterface{{Name: "xe-0/0/0", Description: "[foo]"}}
+
+	_, found := c.get("router1")
+	assert.False(t, found, "empty cache")
+
+	c.set("router1", ifaces)
+	cached, found := c.get("router1")
+	assert.True(t, found, "cached")
+	assert.Equal(t, ifaces, cached, "interfaces")
+
+	_, found = c.get("router2")
+	assert.False(t, found, "other device")
+
+	c.Reset()
+	_, found = c.get("router1")
+	assert.False(t, found, "after reset")
+
+	expired := NewDescriptionCache(-time.Second)
+	expired.set("router1", ifaces)
+	_, found = expired.get("router1")
+	assert.False(t, found, "expired")
+}
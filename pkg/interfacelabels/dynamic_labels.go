@@ -48,17 +48,44 @@ type interfaceLabel struct {
 
 // CollectDescriptions collects labels from descriptions
 func (l *DynamicLabels) CollectDescriptions(device *connector.Device, client collector.Client, ifDescReg *regexp.Regexp) error {
-	r := &result{}
-	err := client.RunCommandAndParse("show interfaces descriptions", r)
+	ifaces, err := retrieveDescriptions(device, client)
 	if err != nil {
-		return errors.Wrap(err, "could not retrieve interface descriptions for "+device.Host)
+		return err
+	}
+
+	l.parseDescriptions(device, ifaces, ifDescReg)
+
+	return nil
+}
+
+// CollectDescriptionsCached collects labels from descriptions, the descriptions are only retrieved from the device if not found in the cache
+func (l *DynamicLabels) CollectDescriptionsCached(device *connector.Device, client collector.Client, ifDescReg *regexp.Regexp, cache *DescriptionCache) error {
+	ifaces, found := cache.get(device.Host)
+	if !found {
+		var err error
+		ifaces, err = retrieveDescriptions(device, client)
+		if err != nil {
+			return err
+		}
+
+		cache.set(device.Host, ifaces)
 	}
 
-	l.parseDescriptions(device, r.Information.Interfaces, ifDescReg)
+	l.parseDescriptions(device, ifaces, ifDescReg)
 
 	return nil
 }
 
+func retrieveDescriptions(device *connector.Device, client collector.Client) ([]phyInterface, error) {
+	r := &result{}
+	err := client.RunCommandAndParse("show interfaces descriptions", r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve interface descriptions for "+device.Host)
+	}
+
+	return r.Information.Interfaces, nil
+}
+
 // LabelNames returns the names for all dynamic labels
 func (l *DynamicLabels) LabelNames() []string {
 	names := make([]string, len(l.labelNames))
@@ -113,6 +140,10 @@ func (l *DynamicLabels) parseDescription(iface phyInterface, ifDescReg *regexp.R
 		return labels
 	}
 
+	if hasNamedGroups(ifDescReg) {
+		return parseNamedGroups(iface.Description, ifDescReg)
+	}
+
 	matches := ifDescReg.FindAllStringSubmatch(iface.Description, -1)
 	for _, m := range matches {
 		n := strings.ToLower(m[1])
@@ -138,3 +169,38 @@ func (l *DynamicLabels) parseDescription(iface phyInterface, ifDescReg *regexp.R
 
 	return labels
 }
+
+func hasNamedGroups(ifDescReg *regexp.Regexp) bool {
+	for _, n := range ifDescReg.SubexpNames() {
+		if len(n) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNamedGroups uses each named capture group of the regex as label, the first non empty value of a group wins
+func parseNamedGroups(description string, ifDescReg *regexp.Regexp) []*interfaceLabel {
+	labels := make([]*interfaceLabel, 0)
+	found := make(map[string]bool)
+	names := ifDescReg.SubexpNames()
+
+	for _, m := range ifDescReg.FindAllStringSubmatch(description, -1) {
+		for i, name := range names {
+			n := strings.ToLower(name)
+
+			if len(m[i]) == 0 || found[n] || !nameRe.Match([]byte(n)) {
+				continue
+			}
+
+			found[n] = true
+			labels = append(labels, &interfaceLabel{
+				name:  n,
+				value: m[i],
+			})
+		}
+	}
+
+	return labels
+}
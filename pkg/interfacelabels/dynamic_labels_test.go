@@ -69,4 +69,31 @@ func TestParseDescriptions(t *testing.T) {
 		assert.Equal(t, []string{"", "", "", "1", ""}, l.ValuesForInterface(d2, if2.Name), "Values if2")
 		assert.Equal(t, []string{"x", "y", "", "", "is"}, l.ValuesForInterface(d3, if3.Name), "Values if3")
 	})
+
+	t.Run("Test named groups", func(t *testing.T) {
+		l := NewDynamicLabels()
+		regex := regexp.MustCompile(`^(?P<Customer>[A-Z]+)-(?P<circuit>\d+)(?:\s+(?P<site>\w+))?`)
+
+		if1 := phyInterface{
+			Name:        "xe-0/0/0",
+			Description: "ACME-4711 fra1",
+		}
+		if2 := phyInterface{
+			Name:        "xe-0/0/1",
+			Description: "INITECH-42",
+		}
+		if3 := phyInterface{
+			Name:        "xe-0/0/3",
+			Description: "uplink [core]",
+		}
+
+		d1 := &connector.Device{Host: "device1"}
+
+		l.parseDescriptions(d1, []phyInterface{if1, if2, if3}, regex)
+
+		assert.Equal(t, []string{"customer", "circuit", "site"}, l.LabelNames(), "Label names")
+		assert.Equal(t, []string{"ACME", "4711", "fra1"}, l.ValuesForInterface(d1, if1.Name), "Values if1")
+		assert.Equal(t, []string{"INITECH", "42", ""}, l.ValuesForInterface(d1, if2.Name), "Values if2")
+		assert.Equal(t, []string{"", "", ""}, l.ValuesForInterface(d1, if3.Name), "Values if3")
+	})
 }
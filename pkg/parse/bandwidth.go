@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var bandwidthUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"tbps", 1e12},
+	{"gbps", 1e9},
+	{"mbps", 1e6},
+	{"kbps", 1e3},
+	{"bps", 1},
+}
+
+// Bandwidth converts a bandwidth string as reported by JunOS (e.g. 10Mbps) into bits per second
+func Bandwidth(s string) (float64, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	if v == "" {
+		return 0, fmt.Errorf("empty bandwidth value")
+	}
+
+	for _, u := range bandwidthUnits {
+		if !strings.HasSuffix(v, u.suffix) {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSuffix(v, u.suffix), 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse bandwidth %q: %w", s, err)
+		}
+
+		return f * u.multiplier, nil
+	}
+
+	return 0, fmt.Errorf("unknown unit in bandwidth %q", s)
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected float64
+		wantErr  bool
+	}{
+		{value: "0bps", expected: 0},
+		{value: "800Kbps", expected: 800e3},
+		{value: "10Mbps", expected: 10e6},
+		{value: "1.5Gbps", expected: 1.5e9},
+		{value: "", wantErr: true},
+		{value: "10M", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			bw, err := Bandwidth(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, bw)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+
+// Package parse contains helpers to parse values from the textual representation used by JunOS
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Uptime parses an uptime as reported by JunOS (e.g. "2w1d 03:04:05", "1d 03:04:05" or "03:04:05") to seconds
+func Uptime(value string) (float64, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty uptime")
+	}
+
+	total := 0.0
+	for _, f := range fields[:len(fields)-1] {
+		d, err := days(f)
+		if err != nil {
+			return 0, err
+		}
+
+		total += d
+	}
+
+	parts := strings.Split(fields[len(fields)-1], ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid uptime: %s", value)
+	}
+
+	if len(parts) == 1 {
+		d, err := days(parts[0])
+		return total + d, err
+	}
+
+	multiplier := 1.0
+	for i := len(parts) - 1; i >= 0; i-- {
+		v, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid uptime: %s", value)
+		}
+
+		total += v * multiplier
+		multiplier *= 60
+	}
+
+	return total, nil
+}
+
+// days parses values like "2w1d" or "3d" to seconds
+func days(s string) (float64, error) {
+	total := 0.0
+	num := ""
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			num += string(r)
+		case r == 'w' || r == 'd':
+			v, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid uptime: %s", s)
+			}
+
+			if r == 'w' {
+				total += v * 7 * 86400
+			} else {
+				total += v * 86400
+			}
+
+			num = ""
+		default:
+			return 0, fmt.Errorf("invalid uptime: %s", s)
+		}
+	}
+
+	if num != "" {
+		return 0, fmt.Errorf("invalid uptime: %s", s)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MIT
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUptime(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected float64
+		wantErr  bool
+	}{
+		{value: "00:00:42", expected: 42},
+		{value: "01:02:03", expected: 3723},
+		{value: "1d 01:02:03", expected: 86400 + 3723},
+		{value: "2w1d 00:00:01", expected: 15*86400 + 1},
+		{value: "3d", expected: 3 * 86400},
+		{value: "", wantErr: true},
+		{value: "foo", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			v, err := Uptime(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, v)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditLog writes a record of every command sent to a device (one JSON object per line)
+type AuditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+type auditRecord struct {
+	Time            time.Time `json:"time"`
+	Device          string    `json:"device"`
+	Transport       string    `json:"transport"`
+	Command         string    `json:"command"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// NewAuditLog creates an audit log writing to w
+func NewAuditLog(w io.Writer) *AuditLog {
+	return &AuditLog{w: w}
+}
+
+// Record writes a record of a command sent to device at the time start
+func (a *AuditLog) Record(device, transport, cmd string, start time.Time, err error) error {
+	r := auditRecord{
+		Time:            start.UTC(),
+		Device:          device,
+		Transport:       transport,
+		Command:         cmd,
+		DurationSeconds: time.Since(start).Seconds(),
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	b, merr := json.Marshal(r)
+	if merr != nil {
+		return merr
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, werr := a.w.Write(append(b, '\n'))
+	return werr
+}
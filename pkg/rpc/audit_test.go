@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogRecord(t *testing.T) {
+	var b bytes.Buffer
+	a := NewAuditLog(&b)
+
+	start := time.Date(2023, 5, 15, 17, 36, 46, 0, time.UTC)
+	assert.NoError(t, a.Record("router1", "ssh", "show bgp summary | display xml", start, nil))
+	assert.NoError(t, a.Record("router2", "netconf", "show version", start, errors.New("connection reset")))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	assert.Equal(t, 2, len(lines), "one line per command")
+
+	var r auditRecord
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &r))
+	assert.Equal(t, "router1", r.Device, "device")
+	assert.Equal(t, "ssh", r.Transport, "transport")
+	assert.Equal(t, "show bgp summary | display xml", r.Command, "command")
+	assert.True(t, r.Time.Equal(start), "time")
+	assert.Empty(t, r.Error, "no error")
+
+	r = auditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &r))
+	assert.Equal(t, "netconf", r.Transport, "transport")
+	assert.Equal(t, "connection reset", r.Error, "error")
+}
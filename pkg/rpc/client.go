@@ -3,9 +3,13 @@
 package rpc
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"log"
+	"sync/atomic"
+	"time"
+
 	"github.com/czerwonk/junos_exporter/pkg/connector"
 )
 
@@ -32,12 +36,47 @@ func WithLicenseInformation() ClientOption {
   }
 }
 
+// WithNetconf runs commands using the NETCONF subsystem instead of the CLI
+func WithNetconf() ClientOption {
+	return func(cl *Client) {
+		cl.netconf = true
+	}
+}
+
+// WithRetries enables retrying commands failing with transient errors using exponential backoff
+func WithRetries(maxRetries int, initialBackoff time.Duration) ClientOption {
+	return func(cl *Client) {
+		cl.maxRetries = maxRetries
+		cl.retryBackoff = initialBackoff
+	}
+}
+
+// WithCommandOverrides replaces the default commands of collectors (keyed by collector name and default command)
+func WithCommandOverrides(overrides map[string]map[string]string) ClientOption {
+	return func(cl *Client) {
+		cl.overrides = overrides
+	}
+}
+
+// WithAuditLog records every command sent to the device (including retries) in the audit log
+func WithAuditLog(a *AuditLog) ClientOption {
+	return func(cl *Client) {
+		cl.audit = a
+	}
+}
+
 // Client sends commands to JunOS and parses results
 type Client struct {
-	conn      *connector.SSHConnection
-	debug     bool
-	satellite bool
-	license   bool
+	conn         *connector.SSHConnection
+	debug        bool
+	satellite    bool
+	license      bool
+	netconf      bool
+	maxRetries   int
+	retryBackoff time.Duration
+	retries      int64
+	overrides    map[string]map[string]string
+	audit        *AuditLog
 }
 
 // NewClient creates a new client to connect to
@@ -60,11 +99,17 @@ func (c *Client) RunCommandAndParse(cmd string, obj interface{}) error {
 
 // RunCommandAndParseWithParser runs a command on JunOS and unmarshals the XML result using the specified parser function
 func (c *Client) RunCommandAndParseWithParser(cmd string, parser Parser) error {
+	return c.RunCommandAndParseWithParserContext(context.Background(), cmd, parser)
+}
+
+// RunCommandAndParseWithParserContext runs a command on JunOS and unmarshals the XML result using the specified parser function.
+// The command is cancelled when ctx is done.
+func (c *Client) RunCommandAndParseWithParserContext(ctx context.Context, cmd string, parser Parser) error {
 	if c.debug {
 		log.Printf("Running command on %s: %s\n", c.conn.Host(), cmd)
 	}
 
-	b, err := c.conn.RunCommand(fmt.Sprintf("%s | display xml", cmd))
+	b, err := c.runCommandWithRetries(ctx, cmd)
 	if err != nil {
 		return err
 	}
@@ -77,6 +122,67 @@ func (c *Client) RunCommandAndParseWithParser(cmd string, parser Parser) error {
 	return err
 }
 
+func (c *Client) runCommand(ctx context.Context, cmd string) ([]byte, error) {
+	if c.netconf {
+		return c.audited("netconf", cmd, func() ([]byte, error) {
+			return c.runNetconfCommand(ctx, cmd)
+		})
+	}
+
+	cmd = fmt.Sprintf("%s | display xml", cmd)
+	return c.audited("ssh", cmd, func() ([]byte, error) {
+		return c.conn.RunCommandContext(ctx, cmd)
+	})
+}
+
+func (c *Client) audited(transport, cmd string, run func() ([]byte, error)) ([]byte, error) {
+	if c.audit == nil {
+		return run()
+	}
+
+	t := time.Now()
+	b, err := run()
+
+	if aerr := c.audit.Record(c.conn.Host(), transport, cmd, t, err); aerr != nil {
+		log.Printf("Could not write audit log record for %s: %v\n", c.conn.Host(), aerr)
+	}
+
+	return b, err
+}
+
+func (c *Client) runCommandWithRetries(ctx context.Context, cmd string) ([]byte, error) {
+	b, err := c.runCommand(ctx, cmd)
+
+	for attempt := 0; attempt < c.maxRetries && isTransientError(err); attempt++ {
+		if c.debug {
+			log.Printf("Retrying command on %s after transient error: %v\n", c.conn.Host(), err)
+		}
+
+		if werr := wait(ctx, backoff(c.retryBackoff, attempt)); werr != nil {
+			return nil, err
+		}
+
+		atomic.AddInt64(&c.retries, 1)
+		b, err = c.runCommand(ctx, cmd)
+	}
+
+	return b, err
+}
+
+// CommandFor returns the command to run instead of cmd for the collector (cmd if no override is configured)
+func (c *Client) CommandFor(collector, cmd string) string {
+	if override, found := c.overrides[collector][cmd]; found {
+		return override
+	}
+
+	return cmd
+}
+
+// Retries returns the number of retries caused by transient errors
+func (c *Client) Retries() int64 {
+	return atomic.LoadInt64(&c.retries)
+}
+
 // Device returns device information for the connected device
 func (c *Client) Device() *connector.Device {
 	return c.conn.Device()
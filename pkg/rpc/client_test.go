@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandFor(t *testing.T) {
+	cl := NewClient(nil, WithCommandOverrides(map[string]map[string]string{
+		"bgp": {"show bgp neighbor": "show bgp neighbor instance all"},
+	}))
+
+	assert.Equal(t, "show bgp neighbor instance all", cl.CommandFor("bgp", "show bgp neighbor"), "override")
+	assert.Equal(t, "show bgp summary", cl.CommandFor("bgp", "show bgp summary"), "other command")
+	assert.Equal(t, "show bgp neighbor", cl.CommandFor("ldp", "show bgp neighbor"), "other collector")
+	assert.Equal(t, "show bgp neighbor", NewClient(nil).CommandFor("bgp", "show bgp neighbor"), "no overrides")
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type netconfReply struct {
+	Errors []struct {
+		Severity string `xml:"error-severity"`
+		Message  string `xml:"error-message"`
+	} `xml:"rpc-error"`
+}
+
+// runNetconfCommand runs a CLI command using the JunOS command RPC. The reply contains the same XML as "| display xml".
+func (c *Client) runNetconfCommand(ctx context.Context, cmd string) ([]byte, error) {
+	b, err := c.conn.RunNetconfRPCContext(ctx, netconfCommandRPC(cmd))
+	if err != nil {
+		return nil, err
+	}
+
+	return b, netconfError(b)
+}
+
+func netconfCommandRPC(cmd string) string {
+	var b bytes.Buffer
+	b.WriteString(`<rpc><command format="xml">`)
+	xml.EscapeText(&b, []byte(cmd))
+	b.WriteString(`</command></rpc>`)
+
+	return b.String()
+}
+
+func netconfError(b []byte) error {
+	r := netconfReply{}
+	if err := xml.Unmarshal(b, &r); err != nil {
+		return errors.Wrap(err, "could not parse rpc-reply")
+	}
+
+	for _, e := range r.Errors {
+		if strings.TrimSpace(e.Severity) == "error" {
+			return errors.New(strings.TrimSpace(e.Message))
+		}
+	}
+
+	return nil
+}
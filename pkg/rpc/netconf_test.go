@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetconfCommandRPC(t *testing.T) {
+	assert.Equal(t, `<rpc><command format="xml">show bgp neighbor</command></rpc>`, netconfCommandRPC("show bgp neighbor"))
+	assert.Equal(t, `<rpc><command format="xml">show interfaces &lt;ge-0/0/0&gt;</command></rpc>`, netconfCommandRPC("show interfaces <ge-0/0/0>"))
+}
+
+func TestNetconfError(t *testing.T) {
+	ok := `<rpc-reply xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<route-summary-information/>
+		<rpc-error>
+			<error-severity>warning</error-severity>
+			<error-message>some warning</error-message>
+		</rpc-error>
+	</rpc-reply>`
+	assert.NoError(t, netconfError([]byte(ok)))
+
+	failed := `<rpc-reply xmlns="urn:ietf:params:xml:ns:netconf:base:1.0">
+		<rpc-error>
+			<error-type>protocol</error-type>
+			<error-severity>error</error-severity>
+			<error-message>
+			syntax error, expecting &lt;command&gt;
+			</error-message>
+		</rpc-error>
+	</rpc-reply>`
+	assert.EqualError(t, netconfError([]byte(failed)), "syntax error, expecting <command>")
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// isTransientError checks whether an error returned by running a command is expected to go away when retrying
+func isTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var openErr *ssh.OpenChannelError
+	if errors.As(err, &openErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "not connected")
+}
+
+// backoff returns the time to wait before the given retry attempt (starting at 0)
+func backoff(initial time.Duration, attempt int) time.Duration {
+	return initial * time.Duration(1<<attempt)
+}
+
+// wait blocks until d elapsed. It returns an error if ctx is done before.
+func wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{name: "no error", err: nil, transient: false},
+		{name: "EOF", err: errors.Wrap(io.EOF, "could not run command"), transient: true},
+		{name: "connection reset", err: errors.Wrap(syscall.ECONNRESET, "could not run command"), transient: true},
+		{name: "session rejected", err: errors.Wrap(&ssh.OpenChannelError{Reason: ssh.Prohibited}, "could not open session"), transient: true},
+		{name: "not connected", err: errors.New("not connected"), transient: true},
+		{name: "deadline exceeded", err: errors.Wrap(context.DeadlineExceeded, "command cancelled"), transient: false},
+		{name: "exit status", err: errors.Wrap(&ssh.ExitError{}, "could not run command"), transient: false},
+		{name: "parse error", err: errors.New("XML syntax error on line 1"), transient: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.transient, isTransientError(test.err))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 1*time.Second, backoff(time.Second, 0))
+	assert.Equal(t, 2*time.Second, backoff(time.Second, 1))
+	assert.Equal(t, 8*time.Second, backoff(time.Second, 3))
+}
+
+func TestWaitHonorsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Error(t, wait(ctx, time.Hour))
+	assert.NoError(t, wait(context.Background(), time.Millisecond))
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RPCClient is the part of rpc.Client used to run the collectors
+type RPCClient interface {
+	// RunCommandAndParseWithParserContext runs a command on JunOS and unmarshals the XML result using the specified parser function
+	RunCommandAndParseWithParserContext(ctx context.Context, cmd string, parser rpc.Parser) error
+
+	// CommandFor returns the command to run for a collector, applying an override if one is set
+	CommandFor(collector, cmd string) string
+
+	// Retries returns the number of retried commands
+	Retries() int64
+
+	// Device returns device information for the connected device
+	Device() *connector.Device
+
+	// IsSatelliteEnabled returns if sattelite features are enabled on the device
+	IsSatelliteEnabled() bool
+
+	// IsScrapingLicenseEnabled returns if license information is scraped
+	IsScrapingLicenseEnabled() bool
+}
+
+var _ RPCClient = (*rpc.Client)(nil)
+
+// NewClient returns a collector.Client running commands using cl, e.g. to run commands outside of a collector
+func NewClient(ctx context.Context, cl RPCClient) collector.Client {
+	c := &client{cl: cl, ctx: ctx}
+	if d := cl.Device(); d != nil {
+		c.target = d.Host
+	}
+
+	return c
+}
+
+// client implements the collector.Client interface for a collector, tracing the commands and applying the RPC overrides for the collector
+type client struct {
+	cl              RPCClient
+	ctx             context.Context
+	target          string
+	collector       string
+	logicalSystem   string
+	routingInstance string
+	durations       *rpcDurations
+}
+
+// RunCommandAndParse implements RunCommandAndParse of the collector.Client interface
+func (c *client) RunCommandAndParse(cmd string, obj interface{}) error {
+	return c.RunCommandAndParseWithParser(cmd, func(b []byte) error {
+		return xml.Unmarshal(b, obj)
+	})
+}
+
+// RunCommandAndParseWithParser implements RunCommandAndParseWithParser of the collector.Client interface
+func (c *client) RunCommandAndParseWithParser(cmd string, parser rpc.Parser) error {
+	cmd = CommandForCollector(c.cl, c.collector, c.logicalSystem, c.routingInstance, cmd)
+
+	ctx, span := tracer.Start(c.ctx, "RunCommandAndParseWithParser", trace.WithAttributes(
+		attribute.String("command", cmd),
+	))
+	defer span.End()
+
+	t := time.Now()
+	err := c.cl.RunCommandAndParseWithParserContext(ctx, cmd, parser)
+	if c.durations != nil {
+		c.durations.record(cmd, time.Since(t))
+	}
+	inst.recordRPC(ctx, c.target, cmd, err)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+// CommandForCollector applies the RPC override configured for the collector, keeping the logical system and routing instance scope of the command
+func CommandForCollector(cl RPCClient, collector, logicalSystem, routingInstance, cmd string) string {
+	suffix := ""
+	if logicalSystem != "" && strings.HasSuffix(cmd, " logical-system "+logicalSystem) {
+		suffix = " logical-system " + logicalSystem
+		cmd = strings.TrimSuffix(cmd, suffix)
+	}
+
+	if routingInstance != "" && strings.HasSuffix(cmd, " instance "+routingInstance) {
+		suffix = " instance " + routingInstance + suffix
+		cmd = strings.TrimSuffix(cmd, " instance "+routingInstance)
+	}
+
+	return cl.CommandFor(collector, cmd) + suffix
+}
+
+// IsSatelliteEnabled implements IsSatelliteEnabled of the collector.Client interface
+func (c *client) IsSatelliteEnabled() bool {
+	return c.cl.IsSatelliteEnabled()
+}
+
+// IsScrapingLicenseEnabled implements IsScrapingLicenseEnabled of the collector.Client interface
+func (c *client) IsScrapingLicenseEnabled() bool {
+	return c.cl.IsScrapingLicenseEnabled()
+}
+
+// Device implements Device of the collector.Client interface
+func (c *client) Device() *connector.Device {
+	return c.cl.Device()
+}
+
+// LogicalSystem implements LogicalSystem of the collector.Client interface
+func (c *client) LogicalSystem() string {
+	return c.logicalSystem
+}
+
+// RoutingInstance implements RoutingInstance of the collector.Client interface
+func (c *client) RoutingInstance() string {
+	return c.routingInstance
+}
+
+// Context implements Context of the collector.Client interface
+func (c *client) Context() context.Context {
+	return c.ctx
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandForCollector(t *testing.T) {
+	cl := rpc.NewClient(nil, rpc.WithCommandOverrides(map[string]map[string]string{
+		"bgp": {"show bgp neighbor": "show bgp neighbor instance all"},
+	}))
+
+	assert.Equal(t, "show bgp neighbor instance all", CommandForCollector(cl, "bgp", "", "", "show bgp neighbor"))
+	assert.Equal(t, "show bgp summary", CommandForCollector(cl, "bgp", "", "", "show bgp summary"))
+	assert.Equal(t, "show bgp neighbor instance all logical-system ls1", CommandForCollector(cl, "bgp", "ls1", "", "show bgp neighbor logical-system ls1"), "logical system")
+	assert.Equal(t, "show bgp neighbor instance all instance vrf1", CommandForCollector(cl, "bgp", "", "vrf1", "show bgp neighbor instance vrf1"), "routing instance")
+	assert.Equal(t, "show bgp neighbor instance all instance vrf1 logical-system ls1", CommandForCollector(cl, "bgp", "ls1", "vrf1", "show bgp neighbor instance vrf1 logical-system ls1"), "routing instance and logical system")
+	assert.Equal(t, "show bgp neighbor logical-system ls1", CommandForCollector(cl, "ldp", "ls1", "", "show bgp neighbor logical-system ls1"), "other collector")
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+const seconds unit.Unit = "s"
+
+// inst records the scrapes using the global meter provider of OpenTelemetry.
+// Nothing is recorded until a meter provider is set (e.g. to export the metrics via OTLP).
+var inst = newInstrumentsOrNoop(global.Meter("github.com/czerwonk/junos_exporter/pkg/scrape"))
+
+type instruments struct {
+	scrapeDuration    instrument.Float64Histogram
+	collectorDuration instrument.Float64Histogram
+	collectorErrors   instrument.Int64Counter
+	rpcs              instrument.Int64Counter
+	rpcErrors         instrument.Int64Counter
+}
+
+func newInstrumentsOrNoop(m metric.Meter) *instruments {
+	i, err := newInstruments(m)
+	if err != nil {
+		otel.Handle(err)
+		i, _ = newInstruments(metric.NewNoopMeter())
+	}
+
+	return i
+}
+
+func newInstruments(m metric.Meter) (*instruments, error) {
+	var errs [5]error
+	i := &instruments{}
+	i.scrapeDuration, errs[0] = m.Float64Histogram("junos.scrape.duration",
+		instrument.WithDescription("Duration of the scrape of a target"),
+		instrument.WithUnit(seconds))
+	i.collectorDuration, errs[1] = m.Float64Histogram("junos.collector.duration",
+		instrument.WithDescription("Duration of a collector for a target"),
+		instrument.WithUnit(seconds))
+	i.collectorErrors, errs[2] = m.Int64Counter("junos.collector.errors",
+		instrument.WithDescription("Number of failed collector runs (including timeouts)"))
+	i.rpcs, errs[3] = m.Int64Counter("junos.rpc.commands",
+		instrument.WithDescription("Number of RPC commands run on a target"))
+	i.rpcErrors, errs[4] = m.Int64Counter("junos.rpc.errors",
+		instrument.WithDescription("Number of RPC commands failed on a target"))
+
+	return i, errors.Join(errs[:]...)
+}
+
+func (i *instruments) recordScrape(ctx context.Context, target string, up bool, d time.Duration) {
+	i.scrapeDuration.Record(ctx, d.Seconds(), attribute.String("target", target), attribute.Bool("up", up))
+}
+
+func (i *instruments) recordCollector(ctx context.Context, target, collector string, failed bool, d time.Duration) {
+	attrs := []attribute.KeyValue{attribute.String("target", target), attribute.String("collector", collector)}
+	if d > 0 {
+		i.collectorDuration.Record(ctx, d.Seconds(), attrs...)
+	}
+
+	if failed {
+		i.collectorErrors.Add(ctx, 1, attrs...)
+	}
+}
+
+func (i *instruments) recordRPC(ctx context.Context, target, cmd string, err error) {
+	attrs := []attribute.KeyValue{attribute.String("target", target), attribute.String("command", normalizeCommand(cmd))}
+	i.rpcs.Add(ctx, 1, attrs...)
+
+	if err != nil {
+		i.rpcErrors.Add(ctx, 1, attrs...)
+	}
+}
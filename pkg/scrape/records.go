@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"sync"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var collectorRecordsDesc *prometheus.Desc
+
+func init() {
+	collectorRecordsDesc = prometheus.NewDesc(prefix+"collector_records", "Number of records (e.g. interfaces, peers, sessions) parsed by the collector during the scrape", []string{"target", "collector"}, nil)
+}
+
+// recordCountingClient counts the records reported by a collector
+type recordCountingClient struct {
+	collector.Client
+	mu       sync.Mutex
+	records  int
+	reported bool
+}
+
+// AddRecords implements collector.RecordCounter interface
+func (c *recordCountingClient) AddRecords(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.records += n
+	c.reported = true
+}
+
+// recordCountingCollector emits the number of records parsed by collectors reporting them
+type recordCountingCollector struct {
+	collector.RPCCollector
+}
+
+// Collect implements collector.RPCCollector interface
+func (c *recordCountingCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	cl := &recordCountingClient{Client: client}
+	err := c.RPCCollector.Collect(cl, ch, labelValues)
+	if err != nil {
+		return err
+	}
+
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if cl.reported {
+		l := append(labelValues[:len(labelValues):len(labelValues)], c.Name())
+		ch <- prometheus.MustNewConstMetric(collectorRecordsDesc, prometheus.GaugeValue, float64(cl.records), l...)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordsTestCollector struct {
+	records []int
+}
+
+func (*recordsTestCollector) Name() string {
+	return "test"
+}
+
+func (*recordsTestCollector) Describe(ch chan<- *prometheus.Desc) {
+}
+
+func (c *recordsTestCollector) Collect(client collector.Client, ch chan<- prometheus.Metric, labelValues []string) error {
+	for _, n := range c.records {
+		collector.ReportRecords(client, n)
+	}
+
+	return nil
+}
+
+func TestRecordCountingCollector(t *testing.T) {
+	ch := make(chan prometheus.Metric, 1)
+	c := &recordCountingCollector{RPCCollector: &recordsTestCollector{records: []int{3, 2}}}
+	assert.NoError(t, c.Collect(nil, ch, []string{"router1"}))
+	assert.Equal(t, 1, len(ch))
+
+	m := &dto.Metric{}
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, float64(5), m.GetGauge().GetValue())
+	assert.Equal(t, "test", m.Label[0].GetValue(), "collector")
+	assert.Equal(t, "router1", m.Label[1].GetValue(), "target")
+
+	c = &recordCountingCollector{RPCCollector: &recordsTestCollector{records: []int{0}}}
+	assert.NoError(t, c.Collect(nil, ch, []string{"router1"}))
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, float64(0), m.GetGauge().GetValue(), "nothing parsed")
+
+	c = &recordCountingCollector{RPCCollector: &recordsTestCollector{}}
+	assert.NoError(t, c.Collect(nil, ch, []string{"router1"}))
+	assert.Equal(t, 0, len(ch), "collector not reporting records")
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	rpcDurationDesc       *prometheus.Desc
+	logicalSystemCmdRegex = regexp.MustCompile(`\s+logical-system\s+\S+`)
+)
+
+func init() {
+	rpcDurationDesc = prometheus.NewDesc(prefix+"rpc_duration_seconds", "Duration of RPC commands during the scrape by target and command", []string{"target", "command"}, nil)
+}
+
+// rpcDurations records the duration of all RPC commands run during the scrape of one target
+type rpcDurations struct {
+	mu        sync.Mutex
+	durations map[string]time.Duration
+}
+
+func newRPCDurations() *rpcDurations {
+	return &rpcDurations{
+		durations: make(map[string]time.Duration),
+	}
+}
+
+func (r *rpcDurations) record(cmd string, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.durations[normalizeCommand(cmd)] += d
+}
+
+func (r *rpcDurations) collect(ch chan<- prometheus.Metric, labelValues []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for cmd, d := range r.durations {
+		l := append(labelValues[:len(labelValues):len(labelValues)], cmd)
+		ch <- prometheus.MustNewConstMetric(rpcDurationDesc, prometheus.GaugeValue, d.Seconds(), l...)
+	}
+}
+
+// normalizeCommand removes the logical system from the command to keep the cardinality of the command label low
+func normalizeCommand(cmd string) string {
+	return logicalSystemCmdRegex.ReplaceAllString(cmd, "")
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCommand(t *testing.T) {
+	assert.Equal(t, "show bgp neighbor", normalizeCommand("show bgp neighbor"))
+	assert.Equal(t, "show bgp neighbor", normalizeCommand("show bgp neighbor logical-system ls1"))
+	assert.Equal(t, "show mpls lsp extensive statistics", normalizeCommand("show mpls lsp extensive statistics logical-system ls1"))
+}
+
+func TestRPCDurationsRecord(t *testing.T) {
+	r := newRPCDurations()
+	r.record("show route summary", time.Second)
+	r.record("show route summary logical-system ls1", 2*time.Second)
+	r.record("show bgp neighbor", time.Second)
+
+	assert.Equal(t, 3*time.Second, r.durations["show route summary"])
+	assert.Equal(t, time.Second, r.durations["show bgp neighbor"])
+}
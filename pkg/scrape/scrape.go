@@ -0,0 +1,412 @@
+// SPDX-License-Identifier: MIT
+
+// Package scrape runs collectors against a set of devices and returns the metrics, without the HTTP server of the exporter.
+// The exporter uses it for every scrape, it can also be used to embed the collection logic in other tools (e.g. for on-demand checks).
+package scrape
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/pkg/collector"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const prefix = "junos_"
+
+var (
+	upDesc                      *prometheus.Desc
+	scrapeDurationDesc          *prometheus.Desc
+	scrapeCollectorDurationDesc *prometheus.Desc
+	scrapeCollectorTimeoutDesc  *prometheus.Desc
+	collectorErrorDesc          *prometheus.Desc
+	rpcRetriesDesc              *prometheus.Desc
+	tracer                      = otel.Tracer("github.com/czerwonk/junos_exporter/pkg/scrape")
+)
+
+func init() {
+	upDesc = prometheus.NewDesc(prefix+"up", "Scrape of target was successful", []string{"target"}, nil)
+	scrapeDurationDesc = prometheus.NewDesc(prefix+"collector_duration_seconds", "Duration of a collector scrape for one target", []string{"target"}, nil)
+	scrapeCollectorDurationDesc = prometheus.NewDesc(prefix+"collect_duration_seconds", "Duration of a scrape by collector and target", []string{"target", "collector"}, nil)
+	scrapeCollectorTimeoutDesc = prometheus.NewDesc(prefix+"collect_timeout", "Collector was cancelled because the scrape timeout of the target or the timeout of the collector exceeded (1 = timed out)", []string{"target", "collector"}, nil)
+	collectorErrorDesc = prometheus.NewDesc(prefix+"collector_error", "Last scrape of the collector failed (1 = failed)", []string{"target", "collector"}, nil)
+	rpcRetriesDesc = prometheus.NewDesc(prefix+"rpc_retries", "Number of retried commands caused by transient errors during the scrape", []string{"target"}, nil)
+}
+
+// Target is a device to scrape
+type Target struct {
+	// Device is the device scraped
+	Device *connector.Device
+
+	// Client is connected to the device, a target without client is reported as down (junos_up 0)
+	Client RPCClient
+
+	// Collectors are run concurrently for the target
+	Collectors []*Collector
+
+	// Timeout limits the duration of the scrape of the target (0 = no timeout)
+	Timeout time.Duration
+
+	// Labels are added to all metrics of the target
+	Labels map[string]string
+}
+
+// NewTarget creates a target for the device the client is connected to running the collectors without timeouts
+func NewTarget(cl RPCClient, collectors ...collector.RPCCollector) *Target {
+	t := &Target{
+		Device: cl.Device(),
+		Client: cl,
+	}
+
+	for _, col := range collectors {
+		t.Collectors = append(t.Collectors, &Collector{RPCCollector: col})
+	}
+
+	return t
+}
+
+// Collector is a collector run for a target
+type Collector struct {
+	collector.RPCCollector
+
+	// Key is the name of the collector in the config (e.g. bgp), used to apply the RPC overrides of the collector
+	Key string
+
+	// Timeout limits the duration of the collector (0 = no timeout)
+	Timeout time.Duration
+}
+
+// CollectFunc runs a collector writing the metrics to ch
+type CollectFunc func(ch chan<- prometheus.Metric) error
+
+// Option configures a Scraper
+type Option func(*Scraper)
+
+// WithLogicalSystem scopes the commands of the collectors to a logical system
+func WithLogicalSystem(name string) Option {
+	return func(s *Scraper) {
+		s.logicalSystem = name
+	}
+}
+
+// WithRoutingInstance scopes the commands of the collectors to a routing instance
+func WithRoutingInstance(name string) Option {
+	return func(s *Scraper) {
+		s.routingInstance = name
+	}
+}
+
+// WithMaxConcurrentTargets limits the number of targets scraped in parallel (0 = no limit)
+func WithMaxConcurrentTargets(n int) Option {
+	return func(s *Scraper) {
+		s.maxConcurrentTargets = n
+	}
+}
+
+// WithMaxSeriesPerCollector limits the number of series of a collector per target, further series are dropped (0 = no limit)
+func WithMaxSeriesPerCollector(n int) Option {
+	return func(s *Scraper) {
+		s.maxSeriesPerCollector = n
+	}
+}
+
+// WithCollectWrapper wraps the collect function of each collector, e.g. to serve results from a cache
+func WithCollectWrapper(wrap func(t *Target, col *Collector, cl collector.Client, collect CollectFunc) CollectFunc) Option {
+	return func(s *Scraper) {
+		s.wrapCollect = wrap
+	}
+}
+
+// WithTargetCollected registers a function called after a target was scraped (up = junos_up), e.g. to export further metrics of the target
+func WithTargetCollected(f func(t *Target, up bool, ch chan<- prometheus.Metric, labelValues []string)) Option {
+	return func(s *Scraper) {
+		s.targetCollected = f
+	}
+}
+
+// Scraper runs the collectors of targets
+type Scraper struct {
+	logicalSystem         string
+	routingInstance       string
+	maxConcurrentTargets  int
+	maxSeriesPerCollector int
+	wrapCollect           func(t *Target, col *Collector, cl collector.Client, collect CollectFunc) CollectFunc
+	targetCollected       func(t *Target, up bool, ch chan<- prometheus.Metric, labelValues []string)
+}
+
+// New creates a new scraper
+func New(opts ...Option) *Scraper {
+	s := &Scraper{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Describe describes the metrics the scraper exports for each target (not including the metrics of the collectors)
+func (s *Scraper) Describe(ch chan<- *prometheus.Desc) {
+	ch <- upDesc
+	ch <- scrapeDurationDesc
+	ch <- scrapeCollectorDurationDesc
+	ch <- scrapeCollectorTimeoutDesc
+	ch <- collectorErrorDesc
+	ch <- rpcRetriesDesc
+	ch <- rpcDurationDesc
+	ch <- seriesTruncatedDesc
+	ch <- collectorRecordsDesc
+}
+
+// Scrape runs the collectors of all targets and returns the gathered metrics.
+// Metrics a collector emitted before it failed are kept, the errors of all failed collectors are returned.
+func (s *Scraper) Scrape(ctx context.Context, targets []*Target) ([]*dto.MetricFamily, error) {
+	r := &run{scraper: s, ctx: ctx, targets: targets}
+
+	reg := prometheus.NewRegistry()
+	err := reg.Register(r)
+	if err != nil {
+		return nil, err
+	}
+
+	mfs, err := reg.Gather()
+
+	return mfs, errors.Join(append(r.errs, err)...)
+}
+
+// run is the prometheus.Collector of a single scrape
+type run struct {
+	scraper *Scraper
+	ctx     context.Context
+	targets []*Target
+	errs    []error
+}
+
+// Describe implements prometheus.Collector interface
+func (r *run) Describe(ch chan<- *prometheus.Desc) {
+	// labels of the targets may differ, so the collector is registered unchecked
+	for _, t := range r.targets {
+		if len(t.Labels) > 0 {
+			return
+		}
+	}
+
+	r.scraper.Describe(ch)
+
+	for _, t := range r.targets {
+		for _, col := range t.Collectors {
+			col.Describe(ch)
+		}
+	}
+}
+
+// Collect implements prometheus.Collector interface
+func (r *run) Collect(ch chan<- prometheus.Metric) {
+	r.errs = r.scraper.Collect(r.ctx, r.targets, ch)
+}
+
+// Collect scrapes all targets, writing the metrics to ch. The errors of all failed collectors are returned.
+func (s *Scraper) Collect(ctx context.Context, targets []*Target, ch chan<- prometheus.Metric) []error {
+	ctx, span := tracer.Start(ctx, "Collect")
+	defer span.End()
+
+	var sem chan struct{}
+	if s.maxConcurrentTargets > 0 {
+		sem = make(chan struct{}, s.maxConcurrentTargets)
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	wg := &sync.WaitGroup{}
+	wg.Add(len(targets))
+	for _, t := range targets {
+		if sem != nil {
+			sem <- struct{}{}
+		}
+
+		go func(t *Target) {
+			defer wg.Done()
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+
+			collectWithStaticLabels(t.Labels, ch, func(ch chan<- prometheus.Metric) {
+				targetErrs := s.collectForTarget(ctx, t, ch)
+
+				mu.Lock()
+				errs = append(errs, targetErrs...)
+				mu.Unlock()
+			})
+		}(t)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func (s *Scraper) collectForTarget(ctx context.Context, t *Target, ch chan<- prometheus.Metric) []error {
+	ctx, span := tracer.Start(ctx, "CollectForHost", trace.WithAttributes(
+		attribute.String("host", t.Device.Host),
+	))
+	defer span.End()
+
+	l := []string{t.Device.Host}
+
+	start := time.Now()
+	up := false
+	defer func() {
+		d := time.Since(start)
+		ch <- prometheus.MustNewConstMetric(scrapeDurationDesc, prometheus.GaugeValue, d.Seconds(), l...)
+		inst.recordScrape(ctx, t.Device.Host, up, d)
+	}()
+
+	if s.targetCollected != nil {
+		defer func() {
+			s.targetCollected(t, up, ch, l)
+		}()
+	}
+
+	if t.Client == nil {
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0, l...)
+		return nil
+	}
+
+	if t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+	}
+
+	// collectors run concurrently, the number of parallel sessions to the device is limited by the connection
+	var mu sync.Mutex
+	var errs []error
+	durations := newRPCDurations()
+	wg := &sync.WaitGroup{}
+	for _, col := range t.Collectors {
+		wg.Add(1)
+		go func(col *Collector) {
+			defer wg.Done()
+
+			err := s.collectWithCollector(ctx, t, col, durations, ch, l)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %s: %w", t.Device.Host, col.Name(), err))
+				mu.Unlock()
+			}
+		}(col)
+	}
+	wg.Wait()
+
+	durations.collect(ch, l)
+
+	ch <- prometheus.MustNewConstMetric(rpcRetriesDesc, prometheus.GaugeValue, float64(t.Client.Retries()), l...)
+
+	if ctx.Err() != nil {
+		log.WithField("host", t.Device.Host).Errorf("Scrape of %s timed out", t.Device)
+		ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0, l...)
+		return errs
+	}
+
+	up = true
+	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 1, l...)
+
+	return errs
+}
+
+func (s *Scraper) collectWithCollector(ctx context.Context, t *Target, col *Collector, durations *rpcDurations, ch chan<- prometheus.Metric, l []string) error {
+	labels := append([]string{}, l...)
+	labels = append(labels, col.Name())
+	// skipped because the scrape expired, reported as failed to not look healthy
+	if ctx.Err() != nil {
+		ch <- prometheus.MustNewConstMetric(scrapeCollectorTimeoutDesc, prometheus.GaugeValue, 1, labels...)
+		ch <- prometheus.MustNewConstMetric(collectorErrorDesc, prometheus.GaugeValue, 1, labels...)
+		inst.recordCollector(ctx, t.Device.Host, col.Name(), true, 0)
+		return ctx.Err()
+	}
+
+	ctx, sp := tracer.Start(ctx, "CollectForHostWithCollector", trace.WithAttributes(
+		attribute.String("collector", col.Name()),
+	))
+	defer sp.End()
+
+	if col.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, col.Timeout)
+		defer cancel()
+	}
+
+	cl := &client{
+		cl:              t.Client,
+		ctx:             ctx,
+		target:          t.Device.Host,
+		collector:       col.Key,
+		logicalSystem:   s.logicalSystem,
+		routingInstance: s.routingInstance,
+		durations:       durations,
+	}
+
+	ct := time.Now()
+	rc := &recordCountingCollector{RPCCollector: col.RPCCollector}
+	var collect CollectFunc = func(ch chan<- prometheus.Metric) error {
+		return rc.Collect(cl, ch, l)
+	}
+
+	if s.wrapCollect != nil {
+		collect = s.wrapCollect(t, col, cl, collect)
+	}
+
+	var err error
+	if s.maxSeriesPerCollector > 0 {
+		var dropped int
+		dropped, err = collectLimited(s.maxSeriesPerCollector, ch, collect)
+		if dropped > 0 {
+			log.WithFields(log.Fields{
+				"host":      t.Device.Host,
+				"collector": col.Name(),
+			}).Warnf("%s: dropped %d series exceeding the limit of %d series per collector", col.Name(), dropped, s.maxSeriesPerCollector)
+		}
+		ch <- prometheus.MustNewConstMetric(seriesTruncatedDesc, prometheus.GaugeValue, float64(dropped), labels...)
+	} else {
+		err = collect(ch)
+	}
+
+	timedOut := 0
+	if ctx.Err() != nil {
+		timedOut = 1
+		if err == nil {
+			err = ctx.Err()
+		}
+	}
+
+	failed := 0
+	if err != nil && err.Error() != "EOF" {
+		failed = 1
+		sp.RecordError(err)
+		sp.SetStatus(codes.Error, err.Error())
+		log.WithFields(log.Fields{
+			"host":      t.Device.Host,
+			"collector": col.Name(),
+		}).Errorln(col.Name() + ": " + err.Error())
+	} else {
+		err = nil
+	}
+
+	d := time.Since(ct)
+	inst.recordCollector(ctx, t.Device.Host, col.Name(), failed == 1, d)
+	ch <- prometheus.MustNewConstMetric(scrapeCollectorDurationDesc, prometheus.GaugeValue, d.Seconds(), labels...)
+	ch <- prometheus.MustNewConstMetric(scrapeCollectorTimeoutDesc, prometheus.GaugeValue, float64(timedOut), labels...)
+	ch <- prometheus.MustNewConstMetric(collectorErrorDesc, prometheus.GaugeValue, float64(failed), labels...)
+
+	return err
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/features/fabric"
+	"github.com/czerwonk/junos_exporter/pkg/features/ntp"
+	"github.com/czerwonk/junos_exporter/pkg/rpc"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type fakeClient struct {
+	outputs map[string]string
+}
+
+func (c *fakeClient) RunCommandAndParseWithParserContext(ctx context.Context, cmd string, parser rpc.Parser) error {
+	out, found := c.outputs[cmd]
+	if !found {
+		return errors.New("command not supported")
+	}
+
+	return parser([]byte(out))
+}
+
+func (c *fakeClient) CommandFor(collector, cmd string) string {
+	return cmd
+}
+
+func (c *fakeClient) Retries() int64 {
+	return 0
+}
+
+func (c *fakeClient) Device() *connector.Device {
+	return &connector.Device{Host: "router1"}
+}
+
+func (c *fakeClient) IsSatelliteEnabled() bool {
+	return false
+}
+
+func (c *fakeClient) IsScrapingLicenseEnabled() bool {
+	return false
+}
+
+func TestScrape(t *testing.T) {
+	cl := &fakeClient{
+		outputs: map[string]string{
+			"show chassis fabric summary": `<rpc-reply>
+    <fm-state-information>
+        <fm-state-item>
+            <plane-slot>0</plane-slot>
+            <state>Online</state>
+            <errors>NO</errors>
+        </fm-state-item>
+    </fm-state-information>
+</rpc-reply>`,
+		},
+	}
+
+	s := New()
+	mfs, err := s.Scrape(context.Background(), []*Target{
+		NewTarget(cl, fabric.NewCollector(), ntp.NewCollector()),
+		{Device: &connector.Device{Host: "router2"}, Collectors: []*Collector{{RPCCollector: fabric.NewCollector()}}},
+	})
+	assert.ErrorContains(t, err, "router1: NTP: command not supported", "error of the NTP collector")
+
+	metrics := make(map[string][]*dto.Metric)
+	for _, mf := range mfs {
+		metrics[mf.GetName()] = mf.Metric
+	}
+
+	assert.Equal(t, 1, len(metrics["junos_fabric_plane_up"]), "metrics of the fabric collector")
+	m := metrics["junos_fabric_plane_up"][0]
+	assert.Equal(t, float64(1), m.GetGauge().GetValue())
+	assert.Equal(t, "router1", targetOf(m))
+
+	up := make(map[string]float64)
+	for _, m := range metrics["junos_up"] {
+		up[targetOf(m)] = m.GetGauge().GetValue()
+	}
+	assert.Equal(t, map[string]float64{"router1": 1, "router2": 0}, up, "junos_up")
+
+	errors := make(map[string]float64)
+	for _, m := range metrics["junos_collector_error"] {
+		for _, lp := range m.Label {
+			if lp.GetName() == "collector" {
+				errors[lp.GetValue()] = m.GetGauge().GetValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{"Fabric": 0, "NTP": 1}, errors, "junos_collector_error")
+}
+
+func targetOf(m *dto.Metric) string {
+	for _, lp := range m.Label {
+		if lp.GetName() == "target" {
+			return lp.GetValue()
+		}
+	}
+
+	return ""
+}
+
+func TestScrapeExpired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cl := &fakeClient{outputs: map[string]string{}}
+	mfs, err := New().Scrape(ctx, []*Target{NewTarget(cl, fabric.NewCollector())})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	values := make(map[string]float64)
+	for _, mf := range mfs {
+		values[mf.GetName()] = mf.Metric[0].GetGauge().GetValue()
+	}
+
+	assert.Equal(t, float64(1), values["junos_collect_timeout"], "collector skipped")
+	assert.Equal(t, float64(1), values["junos_collector_error"], "skipped collector reported as failed")
+	assert.Equal(t, float64(0), values["junos_up"])
+}
+
+func TestScrapeRecordsOTelMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	global.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	defer global.SetMeterProvider(metric.NewNoopMeterProvider())
+
+	cl := &fakeClient{outputs: map[string]string{}}
+	s := New()
+	_, err := s.Scrape(context.Background(), []*Target{NewTarget(cl, ntp.NewCollector())})
+	assert.Error(t, err)
+
+	rm, err := reader.Collect(context.Background())
+	assert.NoError(t, err)
+
+	sums := make(map[string]int64)
+	histograms := make(map[string]uint64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch d := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range d.DataPoints {
+					sums[m.Name] += dp.Value
+				}
+			case metricdata.Histogram:
+				for _, dp := range d.DataPoints {
+					histograms[m.Name] += dp.Count
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int64{"junos.collector.errors": 1, "junos.rpc.commands": 1, "junos.rpc.errors": 1}, sums, "counters")
+	assert.Equal(t, map[string]uint64{"junos.scrape.duration": 1, "junos.collector.duration": 1}, histograms, "histograms")
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var seriesTruncatedDesc *prometheus.Desc
+
+func init() {
+	seriesTruncatedDesc = prometheus.NewDesc(prefix+"collector_series_truncated", "Number of series dropped during the scrape because the collector exceeded max_series_per_collector", []string{"target", "collector"}, nil)
+}
+
+// collectLimited passes at most limit metrics written by collect to ch and returns the number of dropped metrics
+func collectLimited(limit int, ch chan<- prometheus.Metric, collect func(ch chan<- prometheus.Metric) error) (int, error) {
+	mch := make(chan prometheus.Metric)
+	dropped := make(chan int)
+	go func() {
+		n := 0
+		d := 0
+		for m := range mch {
+			if n >= limit {
+				d++
+				continue
+			}
+
+			n++
+			ch <- m
+		}
+		dropped <- d
+	}()
+
+	err := collect(mch)
+	close(mch)
+
+	return <-dropped, err
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectLimited(t *testing.T) {
+	desc := prometheus.NewDesc("test_metric", "Test", []string{"index"}, nil)
+	collect := func(n int) func(ch chan<- prometheus.Metric) error {
+		return func(ch chan<- prometheus.Metric) error {
+			for i := 0; i < n; i++ {
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(i), "x")
+			}
+
+			return nil
+		}
+	}
+
+	ch := make(chan prometheus.Metric, 10)
+	dropped, err := collectLimited(3, ch, collect(5))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dropped, "dropped")
+	assert.Equal(t, 3, len(ch), "passed")
+
+	ch = make(chan prometheus.Metric, 10)
+	dropped, err = collectLimited(3, ch, collect(2))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dropped, "below limit")
+	assert.Equal(t, 2, len(ch), "passed")
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// staticLabelMetric adds the static labels configured for a target to a metric
+type staticLabelMetric struct {
+	prometheus.Metric
+	labels map[string]string
+}
+
+// Write implements prometheus.Metric interface
+func (m *staticLabelMetric) Write(out *dto.Metric) error {
+	err := m.Metric.Write(out)
+	if err != nil {
+		return err
+	}
+
+	for _, lp := range out.Label {
+		if _, found := m.labels[lp.GetName()]; found {
+			return fmt.Errorf("static label %s collides with a label of metric %s", lp.GetName(), m.Desc())
+		}
+	}
+
+	for name, value := range m.labels {
+		name, value := name, value
+		out.Label = append(out.Label, &dto.LabelPair{
+			Name:  &name,
+			Value: &value,
+		})
+	}
+
+	sort.Slice(out.Label, func(i, j int) bool {
+		return out.Label[i].GetName() < out.Label[j].GetName()
+	})
+
+	return nil
+}
+
+// collectWithStaticLabels adds labels to all metrics written by collect before passing them to ch
+func collectWithStaticLabels(labels map[string]string, ch chan<- prometheus.Metric, collect func(ch chan<- prometheus.Metric)) {
+	if len(labels) == 0 {
+		collect(ch)
+		return
+	}
+
+	mch := make(chan prometheus.Metric)
+	done := make(chan struct{})
+	go func() {
+		for m := range mch {
+			ch <- &staticLabelMetric{Metric: m, labels: labels}
+		}
+		close(done)
+	}()
+
+	collect(mch)
+	close(mch)
+	<-done
+}
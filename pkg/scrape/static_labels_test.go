@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+
+package scrape
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectWithStaticLabels(t *testing.T) {
+	desc := prometheus.NewDesc("test_metric", "Test", []string{"target"}, nil)
+	collect := func(ch chan<- prometheus.Metric) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, 1, "router1")
+	}
+
+	ch := make(chan prometheus.Metric, 1)
+	collectWithStaticLabels(map[string]string{"role": "edge", "pop": "fra1"}, ch, collect)
+
+	m := &dto.Metric{}
+	assert.NoError(t, (<-ch).Write(m))
+	assert.Equal(t, 3, len(m.Label))
+	for i, name := range []string{"pop", "role", "target"} {
+		assert.Equal(t, name, m.Label[i].GetName(), "label %d", i)
+	}
+	assert.Equal(t, "fra1", m.Label[0].GetValue())
+
+	ch = make(chan prometheus.Metric, 1)
+	collectWithStaticLabels(map[string]string{"target": "other"}, ch, collect)
+	assert.ErrorContains(t, (<-ch).Write(&dto.Metric{}), "static label target collides")
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+
+package telemetry
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Cache keeps the latest values streamed by the devices
+type Cache struct {
+	mu      sync.RWMutex
+	targets map[string]*targetState
+}
+
+type targetState struct {
+	connected  bool
+	lastUpdate time.Time
+	interfaces map[string]map[string]float64
+}
+
+// Snapshot is a copy of the cached values of a device
+type Snapshot struct {
+	// Connected is set if the subscription to the device is established
+	Connected bool
+
+	// LastUpdate is the time the last notification was received
+	LastUpdate time.Time
+
+	// Interfaces contains the counters (e.g. in-octets) by interface name
+	Interfaces map[string]map[string]float64
+}
+
+// NewCache creates an empty cache
+func NewCache() *Cache {
+	return &Cache{
+		targets: make(map[string]*targetState),
+	}
+}
+
+// Snapshot returns a copy of the values cached for host, found is false if no subscription to host was started
+func (c *Cache) Snapshot(host string) (s Snapshot, found bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	t, found := c.targets[host]
+	if !found {
+		return Snapshot{}, false
+	}
+
+	s = Snapshot{
+		Connected:  t.connected,
+		LastUpdate: t.lastUpdate,
+		Interfaces: make(map[string]map[string]float64, len(t.interfaces)),
+	}
+	for name, counters := range t.interfaces {
+		cp := make(map[string]float64, len(counters))
+		for k, v := range counters {
+			cp[k] = v
+		}
+		s.Interfaces[name] = cp
+	}
+
+	return s, true
+}
+
+func (c *Cache) add(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.targets[host] = &targetState{interfaces: make(map[string]map[string]float64)}
+}
+
+func (c *Cache) remove(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.targets, host)
+}
+
+// setConnected updates the state of the subscription, the values of a lost subscription are dropped since they are not updated anymore
+func (c *Cache) setConnected(host string, connected bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, found := c.targets[host]
+	if !found {
+		return
+	}
+
+	t.connected = connected
+	if !connected {
+		t.interfaces = make(map[string]map[string]float64)
+	}
+}
+
+// update applies the updates and deletes of a notification of the interface counters (/interfaces/interface[name=...]/state/counters/...)
+func (c *Cache) update(host string, n *gpb.Notification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, found := c.targets[host]
+	if !found {
+		return
+	}
+
+	t.lastUpdate = time.Now()
+
+	for _, p := range n.Delete {
+		if name, _, ok := interfaceCounter(n.Prefix, p); ok {
+			delete(t.interfaces, name)
+		}
+	}
+
+	for _, u := range n.Update {
+		name, counter, ok := interfaceCounter(n.Prefix, u.Path)
+		if !ok || counter == "" {
+			continue
+		}
+
+		v, ok := numericValue(u.Val)
+		if !ok {
+			continue
+		}
+
+		if t.interfaces[name] == nil {
+			t.interfaces[name] = make(map[string]float64)
+		}
+		t.interfaces[name][counter] = v
+	}
+}
+
+// interfaceCounter returns the interface name and the counter (empty for a path not pointing to a single counter) of a path below /interfaces/interface
+func interfaceCounter(prefix, path *gpb.Path) (name, counter string, ok bool) {
+	var elems []*gpb.PathElem
+	if prefix != nil {
+		elems = append(elems, prefix.Elem...)
+	}
+	if path != nil {
+		elems = append(elems, path.Elem...)
+	}
+
+	if len(elems) < 2 || elems[0].Name != "interfaces" || elems[1].Name != "interface" {
+		return "", "", false
+	}
+
+	name = elems[1].Key["name"]
+	if name == "" {
+		return "", "", false
+	}
+
+	rest := elems[2:]
+	if len(rest) == 3 && rest[0].Name == "state" && rest[1].Name == "counters" {
+		counter = rest[2].Name
+	} else if len(rest) > 0 {
+		// subinterfaces or other state of the interface
+		return "", "", false
+	}
+
+	return name, counter, true
+}
+
+func numericValue(v *gpb.TypedValue) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	switch x := v.Value.(type) {
+	case *gpb.TypedValue_UintVal:
+		return float64(x.UintVal), true
+	case *gpb.TypedValue_IntVal:
+		return float64(x.IntVal), true
+	case *gpb.TypedValue_DoubleVal:
+		return x.DoubleVal, true
+	case *gpb.TypedValue_FloatVal:
+		return float64(x.FloatVal), true
+	case *gpb.TypedValue_JsonVal:
+		return jsonNumber(x.JsonVal)
+	case *gpb.TypedValue_JsonIetfVal:
+		return jsonNumber(x.JsonIetfVal)
+	case *gpb.TypedValue_StringVal:
+		f, err := strconv.ParseFloat(x.StringVal, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// jsonNumber parses a JSON encoded number, 64 bit counters are encoded as string in JSON IETF (e.g. "12345")
+func jsonNumber(b []byte) (float64, bool) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, false
+	}
+
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
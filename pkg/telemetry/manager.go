@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MIT
+
+// Package telemetry subscribes to the gNMI streaming telemetry of devices and caches the latest values
+package telemetry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// Target is a device to subscribe to
+type Target struct {
+	// Host is the name of the device used as key in the cache
+	Host string
+
+	// Address is the address (host:port) of the gRPC service of the device
+	Address string
+
+	Username string
+	Password string
+
+	TLS                bool
+	CAFile             string
+	InsecureSkipVerify bool
+
+	// SampleInterval is the interval the device samples the subscribed values
+	SampleInterval time.Duration
+}
+
+// Manager maintains the subscriptions to the targets
+type Manager struct {
+	cache             *Cache
+	reconnectInterval time.Duration
+	dialOptions       []grpc.DialOption
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+type subscription struct {
+	target Target
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager creates a manager storing the received values in cache
+func NewManager(cache *Cache, opts ...Option) *Manager {
+	m := &Manager{
+		cache:             cache,
+		reconnectInterval: 30 * time.Second,
+		subscriptions:     make(map[string]*subscription),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Option configures the manager
+type Option func(m *Manager)
+
+// WithReconnectInterval sets the time to wait before a failed subscription is retried
+func WithReconnectInterval(d time.Duration) Option {
+	return func(m *Manager) {
+		m.reconnectInterval = d
+	}
+}
+
+// WithDialOptions adds options used to connect to the targets
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(m *Manager) {
+		m.dialOptions = append(m.dialOptions, opts...)
+	}
+}
+
+// Start subscribes to the targets. Subscriptions to targets missing or changed since the last call are stopped.
+func (m *Manager) Start(targets []Target) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	wanted := make(map[string]Target, len(targets))
+	for _, t := range targets {
+		wanted[t.Host] = t
+	}
+
+	for host, s := range m.subscriptions {
+		t, found := wanted[host]
+		if found && t == s.target {
+			continue
+		}
+
+		m.stop(host, s)
+	}
+
+	for host, t := range wanted {
+		if _, found := m.subscriptions[host]; found {
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := &subscription{target: t, cancel: cancel, done: make(chan struct{})}
+		m.subscriptions[host] = s
+		m.cache.add(host)
+
+		go func() {
+			defer close(s.done)
+			m.run(ctx, s.target)
+		}()
+	}
+}
+
+// Stop ends all subscriptions
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for host, s := range m.subscriptions {
+		m.stop(host, s)
+	}
+}
+
+func (m *Manager) stop(host string, s *subscription) {
+	s.cancel()
+	<-s.done
+
+	delete(m.subscriptions, host)
+	m.cache.remove(host)
+}
+
+func (m *Manager) run(ctx context.Context, t Target) {
+	for {
+		err := m.subscribe(ctx, t)
+		m.cache.setConnected(t.Host, false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Errorf("gNMI subscription to %s (%s) failed: %v", t.Host, t.Address, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.reconnectInterval):
+		}
+	}
+}
+
+func (m *Manager) subscribe(ctx context.Context, t Target) error {
+	creds, err := transportCredentials(t)
+	if err != nil {
+		return err
+	}
+
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, m.dialOptions...)
+	conn, err := grpc.DialContext(ctx, t.Address, opts...)
+	if err != nil {
+		return fmt.Errorf("could not connect: %w", err)
+	}
+	defer conn.Close()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "username", t.Username, "password", t.Password)
+	stream, err := gpb.NewGNMIClient(conn).Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("could not subscribe: %w", err)
+	}
+
+	err = stream.Send(subscribeRequest(t.SampleInterval))
+	if err != nil {
+		return fmt.Errorf("could not send subscribe request: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("stream closed by target")
+		}
+		if err != nil {
+			return err
+		}
+
+		m.cache.setConnected(t.Host, true)
+
+		if n := resp.GetUpdate(); n != nil {
+			m.cache.update(t.Host, n)
+		}
+	}
+}
+
+func subscribeRequest(sampleInterval time.Duration) *gpb.SubscribeRequest {
+	return &gpb.SubscribeRequest{
+		Request: &gpb.SubscribeRequest_Subscribe{
+			Subscribe: &gpb.SubscriptionList{
+				Mode:     gpb.SubscriptionList_STREAM,
+				Encoding: gpb.Encoding_PROTO,
+				Subscription: []*gpb.Subscription{
+					{
+						Path: &gpb.Path{
+							Elem: []*gpb.PathElem{
+								{Name: "interfaces"},
+								{Name: "interface"},
+								{Name: "state"},
+								{Name: "counters"},
+							},
+						},
+						Mode:           gpb.SubscriptionMode_SAMPLE,
+						SampleInterval: uint64(sampleInterval.Nanoseconds()),
+					},
+				},
+			},
+		},
+	}
+}
+
+func transportCredentials(t Target) (credentials.TransportCredentials, error) {
+	if !t.TLS {
+		return insecure.NewCredentials(), nil
+	}
+
+	cfg := &tls.Config{
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if len(t.CAFile) > 0 {
+		b, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA file: %w", err)
+		}
+
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("could not parse CA file %s", t.CAFile)
+		}
+	}
+
+	return credentials.NewTLS(cfg), nil
+}
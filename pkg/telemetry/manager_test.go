@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MIT
+
+package telemetry
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeServer struct {
+	gpb.UnimplementedGNMIServer
+	requests chan *gpb.SubscribeRequest
+	username chan string
+}
+
+func (s *fakeServer) Subscribe(stream gpb.GNMI_SubscribeServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.username <- md.Get("username")[0]
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.requests <- req
+
+	err = stream.Send(&gpb.SubscribeResponse{
+		Response: &gpb.SubscribeResponse_Update{
+			Update: &gpb.Notification{
+				Prefix: &gpb.Path{Elem: []*gpb.PathElem{
+					{Name: "interfaces"},
+					{Name: "interface", Key: map[string]string{"name": "xe-0/0/0"}},
+					{Name: "state"},
+					{Name: "counters"},
+				}},
+				Update: []*gpb.Update{
+					{
+						Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "in-octets"}}},
+						Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1234}},
+					},
+					{
+						Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "out-octets"}}},
+						Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"5678"`)}},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}})
+	if err != nil {
+		return err
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestManager(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	fake := &fakeServer{
+		requests: make(chan *gpb.SubscribeRequest, 1),
+		username: make(chan string, 1),
+	}
+	gpb.RegisterGNMIServer(srv, fake)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	cache := NewCache()
+	m := NewManager(cache, WithDialOptions(grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	})))
+
+	m.Start([]Target{{Host: "router1", Address: "bufconn", Username: "exporter", Password: "secret", SampleInterval: 5 * time.Second}})
+
+	assert.Equal(t, "exporter", <-fake.username)
+
+	req := <-fake.requests
+	sub := req.GetSubscribe()
+	if !assert.NotNil(t, sub) || !assert.Len(t, sub.Subscription, 1) {
+		return
+	}
+	assert.Equal(t, gpb.SubscriptionList_STREAM, sub.Mode)
+	assert.Equal(t, gpb.SubscriptionMode_SAMPLE, sub.Subscription[0].Mode)
+	assert.Equal(t, uint64(5*time.Second), sub.Subscription[0].SampleInterval)
+
+	var s Snapshot
+	assert.Eventually(t, func() bool {
+		s, _ = cache.Snapshot("router1")
+		return s.Connected && len(s.Interfaces) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, map[string]map[string]float64{
+		"xe-0/0/0": {"in-octets": 1234, "out-octets": 5678},
+	}, s.Interfaces)
+	assert.False(t, s.LastUpdate.IsZero())
+
+	m.Stop()
+
+	_, found := cache.Snapshot("router1")
+	assert.False(t, found)
+}
+
+func TestManagerRestartOnChangedTarget(t *testing.T) {
+	cache := NewCache()
+	m := NewManager(cache, WithReconnectInterval(time.Hour))
+	defer m.Stop()
+
+	t1 := Target{Host: "router1", Address: "127.0.0.1:1"}
+	m.Start([]Target{t1, {Host: "router2", Address: "127.0.0.1:1"}})
+
+	s1 := m.subscriptions["router1"]
+	m.Start([]Target{t1, {Host: "router2", Address: "127.0.0.1:2"}})
+
+	assert.Same(t, s1, m.subscriptions["router1"], "unchanged subscription has to be kept")
+	assert.Equal(t, "127.0.0.1:2", m.subscriptions["router2"].target.Address)
+
+	m.Start([]Target{t1})
+	assert.Len(t, m.subscriptions, 1)
+
+	_, found := cache.Snapshot("router2")
+	assert.False(t, found)
+}
+
+func TestCacheUpdate(t *testing.T) {
+	c := NewCache()
+	c.add("router1")
+
+	ifPath := func(name string, elems ...string) *gpb.Path {
+		p := &gpb.Path{Elem: []*gpb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": name}},
+		}}
+		for _, e := range elems {
+			p.Elem = append(p.Elem, &gpb.PathElem{Name: e})
+		}
+		return p
+	}
+	uintVal := func(v uint64) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: v}}
+	}
+
+	c.update("router1", &gpb.Notification{
+		Update: []*gpb.Update{
+			{Path: ifPath("ge-0/0/0", "state", "counters", "in-errors"), Val: uintVal(3)},
+			{Path: ifPath("ge-0/0/1", "state", "counters", "in-errors"), Val: uintVal(4)},
+			{Path: ifPath("ge-0/0/1", "subinterfaces", "subinterface", "state", "counters", "in-octets"), Val: uintVal(5)},
+			{Path: ifPath("ge-0/0/1", "state", "counters", "in-unicast-pkts"), Val: &gpb.TypedValue{Value: &gpb.TypedValue_JsonVal{JsonVal: []byte("42")}}},
+			{Path: ifPath("ge-0/0/1", "state", "counters", "in-octets"), Val: &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}}},
+		},
+	})
+
+	s, found := c.Snapshot("router1")
+	assert.True(t, found)
+	assert.Equal(t, map[string]map[string]float64{
+		"ge-0/0/0": {"in-errors": 3},
+		"ge-0/0/1": {"in-errors": 4, "in-unicast-pkts": 42},
+	}, s.Interfaces)
+
+	c.update("router1", &gpb.Notification{Delete: []*gpb.Path{ifPath("ge-0/0/0")}})
+	s, _ = c.Snapshot("router1")
+	assert.NotContains(t, s.Interfaces, "ge-0/0/0")
+
+	c.setConnected("router1", true)
+	c.setConnected("router1", false)
+	s, _ = c.Snapshot("router1")
+	assert.False(t, s.Connected)
+	assert.Empty(t, s.Interfaces)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/pkg/scrape"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	cacheHitsDesc   *prometheus.Desc
+	cacheMissesDesc *prometheus.Desc
+	scrapeCache     = newResultCache()
+)
+
+func init() {
+	cacheHitsDesc = prometheus.NewDesc(prefix+"cache_hits_total", "Number of collector results served from the scrape cache", []string{"target"}, nil)
+	cacheMissesDesc = prometheus.NewDesc(prefix+"cache_misses_total", "Number of collector results not found in the scrape cache", []string{"target"}, nil)
+}
+
+type resultCacheKey struct {
+	target          string
+	logicalSystem   string
+	routingInstance string
+	collector       string
+}
+
+type resultCacheEntry struct {
+	metrics []prometheus.Metric
+	expires time.Time
+}
+
+// resultCache caches the metrics of a collector per target to avoid running the same commands for repeated scrapes within the TTL
+type resultCache struct {
+	mu      sync.Mutex
+	entries map[resultCacheKey]*resultCacheEntry
+	hits    map[string]float64
+	misses  map[string]float64
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{
+		entries: make(map[resultCacheKey]*resultCacheEntry),
+		hits:    make(map[string]float64),
+		misses:  make(map[string]float64),
+	}
+}
+
+func (c *resultCache) get(key resultCacheKey) ([]prometheus.Metric, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, found := c.entries[key]
+	if found && time.Now().Before(e.expires) {
+		c.hits[key.target]++
+		return e.metrics, true
+	}
+
+	delete(c.entries, key)
+	c.misses[key.target]++
+	return nil, false
+}
+
+func (c *resultCache) set(key resultCacheKey, metrics []prometheus.Metric, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = &resultCacheEntry{
+		metrics: metrics,
+		expires: time.Now().Add(ttl),
+	}
+}
+
+// reset drops all cached results (e.g. after the config was reloaded)
+func (c *resultCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[resultCacheKey]*resultCacheEntry)
+}
+
+func (c *resultCache) collect(ch chan<- prometheus.Metric, labelValues []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target := labelValues[0]
+	ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, c.hits[target], labelValues...)
+	ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, c.misses[target], labelValues...)
+}
+
+// collectCached runs collect unless a result within the TTL is cached. Results are only cached if the collector succeeded.
+func (c *resultCache) collectCached(key resultCacheKey, ttl time.Duration, ctx context.Context, collect scrape.CollectFunc, ch chan<- prometheus.Metric) error {
+	if metrics, found := c.get(key); found {
+		for _, m := range metrics {
+			ch <- m
+		}
+
+		return nil
+	}
+
+	metrics := make([]prometheus.Metric, 0)
+	mch := make(chan prometheus.Metric)
+	done := make(chan struct{})
+	go func() {
+		for m := range mch {
+			metrics = append(metrics, m)
+			ch <- m
+		}
+		close(done)
+	}()
+
+	err := collect(mch)
+	close(mch)
+	<-done
+
+	if err == nil && ctx.Err() == nil {
+		c.set(key, metrics, ttl)
+	}
+
+	return err
+}
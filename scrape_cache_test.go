@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/scrape"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCache(t *testing.T) {
+	c := newResultCache()
+	key := resultCacheKey{target: "router1", collector: "BGP"}
+	m := prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, 1, "router1")
+
+	_, found := c.get(key)
+	assert.False(t, found, "empty cache")
+
+	c.set(key, []prometheus.Metric{m}, time.Minute)
+	metrics, found := c.get(key)
+	assert.True(t, found, "cached")
+	assert.Equal(t, []prometheus.Metric{m}, metrics)
+
+	_, found = c.get(resultCacheKey{target: "router1", logicalSystem: "ls1", collector: "BGP"})
+	assert.False(t, found, "other logical system")
+
+	c.set(key, []prometheus.Metric{m}, -time.Second)
+	_, found = c.get(key)
+	assert.False(t, found, "expired")
+
+	c.set(key, []prometheus.Metric{m}, time.Minute)
+	c.reset()
+	_, found = c.get(key)
+	assert.False(t, found, "reset")
+
+	assert.Equal(t, float64(1), c.hits["router1"])
+	assert.Equal(t, float64(4), c.misses["router1"])
+}
+
+func TestUsesCache(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+
+	cfg = config.New()
+	cfg.Devices = []*config.DeviceConfig{
+		{Host: "router1", MinIntervals: map[string]time.Duration{"bgp": 5 * time.Minute}},
+	}
+
+	target := func(host string) *scrape.Target {
+		return &scrape.Target{
+			Device:     &connector.Device{Host: host},
+			Collectors: []*scrape.Collector{{Key: "interfaces"}, {Key: "bgp"}},
+		}
+	}
+
+	assert.True(t, usesCache(target("router1")), "min interval configured")
+	assert.False(t, usesCache(target("router2")), "neither cache TTL nor min interval configured")
+
+	cfg.CacheTTL = time.Minute
+	assert.True(t, usesCache(target("router2")), "cache TTL configured")
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/interfacelabels"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// hasStaticLabels reports whether static labels are configured for at least one of the devices
+func hasStaticLabels(c *config.Config, devices []*connector.Device) bool {
+	for _, d := range devices {
+		if len(c.LabelsForDevice(d.Host)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateStaticLabels checks that the static labels of each device do not collide with the labels of the metrics exported for the device
+func validateStaticLabels(c *config.Config, devices []*connector.Device) error {
+	for _, d := range devices {
+		labels := c.LabelsForDevice(d.Host)
+		if len(labels) == 0 {
+			continue
+		}
+
+		jc := &junosCollector{
+			collectors: collectorsForDevices([]*connector.Device{d}, c, "", interfacelabels.NewDynamicLabels()),
+		}
+
+		err := prometheus.WrapRegistererWith(labels, prometheus.NewRegistry()).Register(jc)
+		if err != nil {
+			return fmt.Errorf("device %s: labels: %w", d.Host, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStaticLabels(t *testing.T) {
+	c := config.New()
+	c.Features.BGP = true
+	devices := []*connector.Device{{Host: "router1"}}
+
+	c.Labels = map[string]string{"region": "eu"}
+	assert.NoError(t, validateStaticLabels(c, devices))
+
+	c.Labels = map[string]string{"asn": "65000"}
+	assert.ErrorContains(t, validateStaticLabels(c, devices), "device router1: labels:")
+}
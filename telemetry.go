@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/telemetry"
+)
+
+const (
+	defaultGNMIPort           = 32767
+	defaultGNMISampleInterval = 10 * time.Second
+)
+
+// telemetryTargets returns the devices to subscribe to via gNMI (devices the gnmi feature is enabled for, host patterns are not subscribed)
+func telemetryTargets(c *config.Config, devices []*connector.Device) ([]telemetry.Target, error) {
+	var targets []telemetry.Target
+	for _, d := range devices {
+		if !c.FeaturesForDevice(d.Host).GNMI || !c.CollectorSetForDevice(d.Host).Allows("gnmi") {
+			continue
+		}
+
+		if c.GNMI == nil {
+			return nil, fmt.Errorf("device %s: gnmi feature is enabled but gnmi is not configured", d.Host)
+		}
+
+		port := c.GNMI.Port
+		if port == 0 {
+			port = defaultGNMIPort
+		}
+
+		interval := c.GNMI.SampleInterval
+		if interval == 0 {
+			interval = defaultGNMISampleInterval
+		}
+
+		targets = append(targets, telemetry.Target{
+			Host:               d.Host,
+			Address:            gnmiAddress(d.Host, port),
+			Username:           c.GNMI.Username,
+			Password:           c.GNMI.Password,
+			TLS:                c.GNMI.TLS,
+			CAFile:             c.GNMI.CAFile,
+			InsecureSkipVerify: c.GNMI.InsecureSkipVerify,
+			SampleInterval:     interval,
+		})
+	}
+
+	return targets, nil
+}
+
+// gnmiAddress replaces the SSH port a host might contain by the port of the gRPC service.
+// IPv6 addresses might be enclosed in brackets.
+func gnmiAddress(host string, port int) string {
+	h, _, err := net.SplitHostPort(host)
+	if err != nil {
+		// no port given (or an IPv6 address without brackets)
+		h = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	}
+
+	return net.JoinHostPort(h, strconv.Itoa(port))
+}
+
+// startTelemetry subscribes to the devices of the loaded config the gnmi feature is enabled for
+func startTelemetry() error {
+	targets, err := telemetryTargets(cfg, devices)
+	if err != nil {
+		return err
+	}
+
+	telemetryManager = telemetry.NewManager(telemetryCache)
+	telemetryManager.Start(targets)
+
+	return nil
+}
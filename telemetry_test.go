@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/czerwonk/junos_exporter/internal/config"
+	"github.com/czerwonk/junos_exporter/pkg/connector"
+	"github.com/czerwonk/junos_exporter/pkg/telemetry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTelemetryTargets(t *testing.T) {
+	c := config.New()
+	c.Features.GNMI = true
+	c.Devices = []*config.DeviceConfig{
+		{Host: "router1"},
+		{Host: "router2:2222"},
+		{Host: "router3", Features: &config.FeatureConfig{Interfaces: true}},
+	}
+	devs := []*connector.Device{{Host: "router1"}, {Host: "router2:2222"}, {Host: "router3"}}
+
+	_, err := telemetryTargets(c, devs)
+	assert.ErrorContains(t, err, "device router1: gnmi feature is enabled but gnmi is not configured")
+
+	c.GNMI = &config.GNMIConfig{Username: "exporter", Password: "secret"}
+	targets, err := telemetryTargets(c, devs)
+	assert.NoError(t, err)
+	assert.Equal(t, []telemetry.Target{
+		{Host: "router1", Address: "router1:32767", Username: "exporter", Password: "secret", SampleInterval: 10 * time.Second},
+		{Host: "router2:2222", Address: "router2:32767", Username: "exporter", Password: "secret", SampleInterval: 10 * time.Second},
+	}, targets)
+
+	c.GNMI.Port = 50051
+	c.GNMI.SampleInterval = time.Minute
+	targets, err = telemetryTargets(c, devs[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, "router1:50051", targets[0].Address)
+	assert.Equal(t, time.Minute, targets[0].SampleInterval)
+}
+
+func TestGNMIAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{name: "hostname", host: "router1", expected: "router1:32767"},
+		{name: "hostname with port", host: "router1:2222", expected: "router1:32767"},
+		{name: "IPv4 with port", host: "192.0.2.1:22", expected: "192.0.2.1:32767"},
+		{name: "IPv6 without port", host: "[2001:db8::1]", expected: "[2001:db8::1]:32767"},
+		{name: "IPv6 without port and brackets", host: "2001:db8::1", expected: "[2001:db8::1]:32767"},
+		{name: "IPv6 with port", host: "[2001:db8::1]:2222", expected: "[2001:db8::1]:32767"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, gnmiAddress(test.host, defaultGNMIPort))
+		})
+	}
+}
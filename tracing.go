@@ -5,13 +5,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 
-	"github.com/czerwonk/junos_exporter/pkg/connector"
-	"github.com/czerwonk/junos_exporter/pkg/rpc"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
@@ -109,47 +106,8 @@ func resourceDefinition() *resource.Resource {
 	)
 }
 
-type clientTracingAdapter struct {
-	cl  *rpc.Client
-	ctx context.Context
-}
-
-// RunCommandAndParse implements RunCommandAndParse of the collector.Client interface
-func (cta *clientTracingAdapter) RunCommandAndParse(cmd string, obj interface{}) error {
-	return cta.cl.RunCommandAndParse(cmd, obj)
-}
-
-// RunCommandAndParseWithParser implements RunCommandAndParseWithParser of the collector.Client interface
-func (cta *clientTracingAdapter) RunCommandAndParseWithParser(cmd string, parser rpc.Parser) error {
-	_, span := tracer.Start(cta.ctx, "RunCommandAndParseWithParser", trace.WithAttributes(
-		attribute.String("command", cmd),
-	))
-	defer span.End()
-
-	err := cta.cl.RunCommandAndParseWithParser(cmd, parser)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-	}
-
-	return err
-}
-
-// IsSatelliteEnabled implements IsSatelliteEnabled of the collector.Client interface
-func (cta *clientTracingAdapter) IsSatelliteEnabled() bool {
-	return cta.cl.IsSatelliteEnabled()
-}
-
-func (cta *clientTracingAdapter) IsScrapingLicenseEnabled() bool {
-	return cta.cl.IsScrapingLicenseEnabled()
-}
-
-// Device implements Device of the collector.Client interface
-func (cta *clientTracingAdapter) Device() *connector.Device {
-	return cta.cl.Device()
-}
-
-// Context implements Context of the collector.Client interface
-func (cta *clientTracingAdapter) Context() context.Context {
-	return cta.ctx
+// contextFromRequest extracts the W3C trace context (traceparent header) of an incoming request.
+// Spans started from the returned context are children of the remote span, without a header a new trace is started.
+func contextFromRequest(r *http.Request) context.Context {
+	return propagation.TraceContext{}.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
 }
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestContextFromRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	sc := trace.SpanContextFromContext(contextFromRequest(r))
+	assert.True(t, sc.IsValid(), "valid span context")
+	assert.True(t, sc.IsRemote(), "remote span context")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID().String())
+
+	r = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	assert.False(t, trace.SpanContextFromContext(contextFromRequest(r)).IsValid(), "no traceparent header")
+}